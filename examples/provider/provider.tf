@@ -3,3 +3,32 @@ provider "openhab" {
 
   api_token = "oh.terraform.Bcdp395XKpVGf4GVlOoAjbHO7nl9u56AA06kOLB788Sx9BcAwKuIvjvwcLWQ6AQWngCVmHhCFOGnsnodl43B2g"
 }
+
+# endpoint and credentials can also be taken from the environment, e.g. in CI:
+# OPENHAB_ENDPOINT, OPENHAB_API_TOKEN or OPENHAB_USERNAME and OPENHAB_PASSWORD
+provider "openhab" {
+  alias = "from_environment"
+}
+
+# openHAB behind a reverse proxy using an internal CA and client certificates
+provider "openhab" {
+  alias    = "mtls"
+  endpoint = "https://openhab.internal/rest"
+
+  api_token = "oh.terraform.Bcdp395XKpVGf4GVlOoAjbHO7nl9u56AA06kOLB788Sx9BcAwKuIvjvwcLWQ6AQWngCVmHhCFOGnsnodl43B2g"
+
+  ca_cert_file     = "/etc/ssl/internal-ca.pem"
+  client_cert_file = "/etc/ssl/terraform.pem"
+  client_key_file  = "/etc/ssl/terraform-key.pem"
+}
+
+# tag all managed items, e.g. to find them in the UI
+provider "openhab" {
+  alias    = "tagged"
+  endpoint = "http://openhab:8080/rest"
+
+  api_token = "oh.terraform.Bcdp395XKpVGf4GVlOoAjbHO7nl9u56AA06kOLB788Sx9BcAwKuIvjvwcLWQ6AQWngCVmHhCFOGnsnodl43B2g"
+
+  default_tags        = ["terraform", "production"]
+  default_group_names = ["gTerraform"]
+}
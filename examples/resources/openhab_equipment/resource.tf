@@ -0,0 +1,15 @@
+resource "openhab_equipment" "ceiling_light" {
+  type   = "Lightbulb"
+  label  = "Ceiling Light"
+  parent = openhab_location.living_room.name
+}
+
+resource "openhab_item" "ceiling_light_switch" {
+  name = "LivingRoom_CeilingLight_Switch"
+
+  type  = "Switch"
+  label = "Ceiling Light"
+
+  tags        = ["Switch", "Light"]
+  group_names = [openhab_equipment.ceiling_light.name]
+}
@@ -9,4 +9,30 @@ resource "openhab_item" "example" {
 
   tags        = ["tag1", "tag2"]
   group_names = ["group_1"]
+
+  metadata = {
+    stateDescription = {
+      value = " "
+      config = jsonencode({
+        pattern  = "%.1f kWh"
+        readOnly = true
+      })
+    }
+    expire = {
+      value = "1h,state=UNDEF"
+    }
+  }
+}
+
+resource "openhab_item" "example_group" {
+  name = "test_group"
+
+  type       = "Group"
+  group_type = "Number:Temperature"
+
+  label = "Average Temperature"
+
+  function {
+    name = "AVG"
+  }
 }
@@ -0,0 +1,2 @@
+# Item metadata can be imported using the item name and namespace separated by a colon
+terraform import openhab_item_metadata.example_metadata test_item:homekit
@@ -0,0 +1,10 @@
+resource "openhab_item_metadata" "example_metadata" {
+  item_name = "test_item"
+  namespace = "homekit"
+
+  value = "TemperatureSensor"
+  config = jsonencode({
+    minValue = -20
+    maxValue = 50
+  })
+}
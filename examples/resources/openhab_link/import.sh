@@ -0,0 +1,2 @@
+# Links can be imported using the item name and channel UID separated by a dash
+terraform import openhab_link.example_link test_item-modbus:data:smartenergymeter:L3:Current:number
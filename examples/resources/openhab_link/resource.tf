@@ -8,3 +8,13 @@ resource "openhab_link" "example_link" {
     pre-gain-offset = "0"
   }
 }
+
+resource "openhab_link" "example_offset_link" {
+  item_name   = "test_item"
+  channel_uid = "modbus:data:smartenergymeter:L3:Power:number"
+
+  configuration_json = jsonencode({
+    profile = "system:offset"
+    offset  = 10
+  })
+}
@@ -0,0 +1,14 @@
+resource "openhab_location" "ground_floor" {
+  name = "GroundFloor"
+
+  type  = "GroundFloor"
+  label = "Ground Floor"
+}
+
+resource "openhab_location" "living_room" {
+  type   = "LivingRoom"
+  label  = "Living Room"
+  parent = openhab_location.ground_floor.name
+
+  category = "sofa"
+}
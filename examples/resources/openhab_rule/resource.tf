@@ -0,0 +1,37 @@
+resource "openhab_rule" "example_rule" {
+  uid  = "turn_on_light_on_motion"
+  name = "Turn on light on motion"
+
+  tags = ["lighting"]
+
+  triggers {
+    id   = "1"
+    type = "core.ItemStateChangeTrigger"
+
+    configuration = jsonencode({
+      itemName = "motion_sensor"
+      state    = "ON"
+    })
+  }
+
+  conditions {
+    id   = "2"
+    type = "core.ItemStateCondition"
+
+    configuration = jsonencode({
+      itemName = "light"
+      operator = "="
+      state    = "OFF"
+    })
+  }
+
+  actions {
+    id   = "3"
+    type = "core.ItemCommandAction"
+
+    configuration = jsonencode({
+      itemName = "light"
+      command  = "ON"
+    })
+  }
+}
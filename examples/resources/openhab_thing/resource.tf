@@ -0,0 +1,12 @@
+resource "openhab_thing" "example_thing" {
+  uid            = "modbus:tcp:smartenergymeter"
+  thing_type_uid = "modbus:tcp"
+
+  label    = "Smart Energy Meter"
+  location = "Basement"
+
+  configuration = {
+    host = "192.168.1.50"
+    port = "502"
+  }
+}
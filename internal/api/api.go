@@ -1,6 +1,6 @@
-// Package ApispecOpenhab3 provides primitives to interact with the openapi HTTP API.
+// Package api provides primitives to interact with the openapi HTTP API.
 //
-// Code generated by github.com/deepmap/oapi-codegen version v1.9.0 DO NOT EDIT.
+// Code generated by github.com/deepmap/oapi-codegen version v1.9.1 DO NOT EDIT.
 package api
 
 import (
@@ -212,17 +212,12 @@ type Action_Inputs struct {
 
 // ActionDTO defines model for ActionDTO.
 type ActionDTO struct {
-	Configuration *ActionDTO_Configuration `json:"configuration,omitempty"`
-	Description   *string                  `json:"description,omitempty"`
-	Id            *string                  `json:"id,omitempty"`
-	Inputs        *ActionDTO_Inputs        `json:"inputs,omitempty"`
-	Label         *string                  `json:"label,omitempty"`
-	Type          *string                  `json:"type,omitempty"`
-}
-
-// ActionDTO_Configuration defines model for ActionDTO.Configuration.
-type ActionDTO_Configuration struct {
-	AdditionalProperties map[string]map[string]interface{} `json:"-"`
+	Configuration *map[string]interface{} `json:"configuration,omitempty"`
+	Description   *string                 `json:"description,omitempty"`
+	Id            *string                 `json:"id,omitempty"`
+	Inputs        *ActionDTO_Inputs       `json:"inputs,omitempty"`
+	Label         *string                 `json:"label,omitempty"`
+	Type          *string                 `json:"type,omitempty"`
 }
 
 // ActionDTO_Inputs defines model for ActionDTO.Inputs.
@@ -288,22 +283,17 @@ type BindingInfoDTO struct {
 
 // ChannelDTO defines model for ChannelDTO.
 type ChannelDTO struct {
-	AutoUpdatePolicy *string                   `json:"autoUpdatePolicy,omitempty"`
-	ChannelTypeUID   *string                   `json:"channelTypeUID,omitempty"`
-	Configuration    *ChannelDTO_Configuration `json:"configuration,omitempty"`
-	DefaultTags      *[]string                 `json:"defaultTags,omitempty"`
-	Description      *string                   `json:"description,omitempty"`
-	Id               *string                   `json:"id,omitempty"`
-	ItemType         *string                   `json:"itemType,omitempty"`
-	Kind             *string                   `json:"kind,omitempty"`
-	Label            *string                   `json:"label,omitempty"`
-	Properties       *ChannelDTO_Properties    `json:"properties,omitempty"`
-	Uid              *string                   `json:"uid,omitempty"`
-}
-
-// ChannelDTO_Configuration defines model for ChannelDTO.Configuration.
-type ChannelDTO_Configuration struct {
-	AdditionalProperties map[string]map[string]interface{} `json:"-"`
+	AutoUpdatePolicy *string                 `json:"autoUpdatePolicy,omitempty"`
+	ChannelTypeUID   *string                 `json:"channelTypeUID,omitempty"`
+	Configuration    *map[string]interface{} `json:"configuration,omitempty"`
+	DefaultTags      *[]string               `json:"defaultTags,omitempty"`
+	Description      *string                 `json:"description,omitempty"`
+	Id               *string                 `json:"id,omitempty"`
+	ItemType         *string                 `json:"itemType,omitempty"`
+	Kind             *string                 `json:"kind,omitempty"`
+	Label            *string                 `json:"label,omitempty"`
+	Properties       *ChannelDTO_Properties  `json:"properties,omitempty"`
+	Uid              *string                 `json:"uid,omitempty"`
 }
 
 // ChannelDTO_Properties defines model for ChannelDTO.Properties.
@@ -381,17 +371,12 @@ type Condition_Inputs struct {
 
 // ConditionDTO defines model for ConditionDTO.
 type ConditionDTO struct {
-	Configuration *ConditionDTO_Configuration `json:"configuration,omitempty"`
-	Description   *string                     `json:"description,omitempty"`
-	Id            *string                     `json:"id,omitempty"`
-	Inputs        *ConditionDTO_Inputs        `json:"inputs,omitempty"`
-	Label         *string                     `json:"label,omitempty"`
-	Type          *string                     `json:"type,omitempty"`
-}
-
-// ConditionDTO_Configuration defines model for ConditionDTO.Configuration.
-type ConditionDTO_Configuration struct {
-	AdditionalProperties map[string]map[string]interface{} `json:"-"`
+	Configuration *map[string]interface{} `json:"configuration,omitempty"`
+	Description   *string                 `json:"description,omitempty"`
+	Id            *string                 `json:"id,omitempty"`
+	Inputs        *ConditionDTO_Inputs    `json:"inputs,omitempty"`
+	Label         *string                 `json:"label,omitempty"`
+	Type          *string                 `json:"type,omitempty"`
 }
 
 // ConditionDTO_Inputs defines model for ConditionDTO.Inputs.
@@ -524,23 +509,18 @@ type DiscoveryResultDTO_Properties struct {
 
 // EnrichedChannelDTO defines model for EnrichedChannelDTO.
 type EnrichedChannelDTO struct {
-	AutoUpdatePolicy *string                           `json:"autoUpdatePolicy,omitempty"`
-	ChannelTypeUID   *string                           `json:"channelTypeUID,omitempty"`
-	Configuration    *EnrichedChannelDTO_Configuration `json:"configuration,omitempty"`
-	DefaultTags      *[]string                         `json:"defaultTags,omitempty"`
-	Description      *string                           `json:"description,omitempty"`
-	Id               *string                           `json:"id,omitempty"`
-	ItemType         *string                           `json:"itemType,omitempty"`
-	Kind             *string                           `json:"kind,omitempty"`
-	Label            *string                           `json:"label,omitempty"`
-	LinkedItems      *[]string                         `json:"linkedItems,omitempty"`
-	Properties       *EnrichedChannelDTO_Properties    `json:"properties,omitempty"`
-	Uid              *string                           `json:"uid,omitempty"`
-}
-
-// EnrichedChannelDTO_Configuration defines model for EnrichedChannelDTO.Configuration.
-type EnrichedChannelDTO_Configuration struct {
-	AdditionalProperties map[string]map[string]interface{} `json:"-"`
+	AutoUpdatePolicy *string                        `json:"autoUpdatePolicy,omitempty"`
+	ChannelTypeUID   *string                        `json:"channelTypeUID,omitempty"`
+	Configuration    *map[string]interface{}        `json:"configuration,omitempty"`
+	DefaultTags      *[]string                      `json:"defaultTags,omitempty"`
+	Description      *string                        `json:"description,omitempty"`
+	Id               *string                        `json:"id,omitempty"`
+	ItemType         *string                        `json:"itemType,omitempty"`
+	Kind             *string                        `json:"kind,omitempty"`
+	Label            *string                        `json:"label,omitempty"`
+	LinkedItems      *[]string                      `json:"linkedItems,omitempty"`
+	Properties       *EnrichedChannelDTO_Properties `json:"properties,omitempty"`
+	Uid              *string                        `json:"uid,omitempty"`
 }
 
 // EnrichedChannelDTO_Properties defines model for EnrichedChannelDTO.Properties.
@@ -550,15 +530,10 @@ type EnrichedChannelDTO_Properties struct {
 
 // EnrichedItemChannelLinkDTO defines model for EnrichedItemChannelLinkDTO.
 type EnrichedItemChannelLinkDTO struct {
-	ChannelUID    *string            `json:"channelUID,omitempty"`
-	Configuration *map[string]string `json:"configuration,omitempty"`
-	Editable      *bool              `json:"editable,omitempty"`
-	ItemName      *string            `json:"itemName,omitempty"`
-}
-
-// EnrichedItemChannelLinkDTO_Configuration defines model for EnrichedItemChannelLinkDTO.Configuration.
-type EnrichedItemChannelLinkDTO_Configuration struct {
-	AdditionalProperties map[string]map[string]interface{} `json:"-"`
+	ChannelUID    *string                 `json:"channelUID,omitempty"`
+	Configuration *map[string]interface{} `json:"configuration,omitempty"`
+	Editable      *bool                   `json:"editable,omitempty"`
+	ItemName      *string                 `json:"itemName,omitempty"`
 }
 
 // EnrichedItemDTO defines model for EnrichedItemDTO.
@@ -566,7 +541,9 @@ type EnrichedItemDTO struct {
 	Category           *string                   `json:"category,omitempty"`
 	CommandDescription *CommandDescription       `json:"commandDescription,omitempty"`
 	Editable           *bool                     `json:"editable,omitempty"`
+	Function           *GroupFunctionDTO         `json:"function,omitempty"`
 	GroupNames         *[]string                 `json:"groupNames,omitempty"`
+	GroupType          *string                   `json:"groupType,omitempty"`
 	Label              *string                   `json:"label,omitempty"`
 	Link               *string                   `json:"link,omitempty"`
 	Metadata           *EnrichedItemDTO_Metadata `json:"metadata,omitempty"`
@@ -588,7 +565,7 @@ type EnrichedRuleDTO struct {
 	Actions            *[]ActionDTO                     `json:"actions,omitempty"`
 	Conditions         *[]ConditionDTO                  `json:"conditions,omitempty"`
 	ConfigDescriptions *[]ConfigDescriptionParameterDTO `json:"configDescriptions,omitempty"`
-	Configuration      *EnrichedRuleDTO_Configuration   `json:"configuration,omitempty"`
+	Configuration      *map[string]interface{}          `json:"configuration,omitempty"`
 	Description        *string                          `json:"description,omitempty"`
 	Editable           *bool                            `json:"editable,omitempty"`
 	Name               *string                          `json:"name,omitempty"`
@@ -600,32 +577,22 @@ type EnrichedRuleDTO struct {
 	Visibility         *EnrichedRuleDTOVisibility       `json:"visibility,omitempty"`
 }
 
-// EnrichedRuleDTO_Configuration defines model for EnrichedRuleDTO.Configuration.
-type EnrichedRuleDTO_Configuration struct {
-	AdditionalProperties map[string]map[string]interface{} `json:"-"`
-}
-
 // EnrichedRuleDTOVisibility defines model for EnrichedRuleDTO.Visibility.
 type EnrichedRuleDTOVisibility string
 
 // EnrichedThingDTO defines model for EnrichedThingDTO.
 type EnrichedThingDTO struct {
-	UID            *string                         `json:"UID,omitempty"`
-	BridgeUID      *string                         `json:"bridgeUID,omitempty"`
-	Channels       *[]EnrichedChannelDTO           `json:"channels,omitempty"`
-	Configuration  *EnrichedThingDTO_Configuration `json:"configuration,omitempty"`
-	Editable       *bool                           `json:"editable,omitempty"`
-	FirmwareStatus *FirmwareStatusDTO              `json:"firmwareStatus,omitempty"`
-	Label          *string                         `json:"label,omitempty"`
-	Location       *string                         `json:"location,omitempty"`
-	Properties     *EnrichedThingDTO_Properties    `json:"properties,omitempty"`
-	StatusInfo     *ThingStatusInfo                `json:"statusInfo,omitempty"`
-	ThingTypeUID   *string                         `json:"thingTypeUID,omitempty"`
-}
-
-// EnrichedThingDTO_Configuration defines model for EnrichedThingDTO.Configuration.
-type EnrichedThingDTO_Configuration struct {
-	AdditionalProperties map[string]map[string]interface{} `json:"-"`
+	UID            *string                      `json:"UID,omitempty"`
+	BridgeUID      *string                      `json:"bridgeUID,omitempty"`
+	Channels       *[]EnrichedChannelDTO        `json:"channels,omitempty"`
+	Configuration  *map[string]interface{}      `json:"configuration,omitempty"`
+	Editable       *bool                        `json:"editable,omitempty"`
+	FirmwareStatus *FirmwareStatusDTO           `json:"firmwareStatus,omitempty"`
+	Label          *string                      `json:"label,omitempty"`
+	Location       *string                      `json:"location,omitempty"`
+	Properties     *EnrichedThingDTO_Properties `json:"properties,omitempty"`
+	StatusInfo     *ThingStatusInfo             `json:"statusInfo,omitempty"`
+	ThingTypeUID   *string                      `json:"thingTypeUID,omitempty"`
 }
 
 // EnrichedThingDTO_Properties defines model for EnrichedThingDTO.Properties.
@@ -736,9 +703,9 @@ type IconSetFormats string
 
 // ItemChannelLinkDTO defines model for ItemChannelLinkDTO.
 type ItemChannelLinkDTO struct {
-	ChannelUID    *string            `json:"channelUID,omitempty"`
-	Configuration *map[string]string `json:"configuration,omitempty"`
-	ItemName      *string            `json:"itemName,omitempty"`
+	ChannelUID    *string                 `json:"channelUID,omitempty"`
+	Configuration *map[string]interface{} `json:"configuration,omitempty"`
+	ItemName      *string                 `json:"itemName,omitempty"`
 }
 
 // ItemHistoryDTO defines model for ItemHistoryDTO.
@@ -763,13 +730,8 @@ type MappingDTO struct {
 
 // MetadataDTO defines model for MetadataDTO.
 type MetadataDTO struct {
-	Config *MetadataDTO_Config `json:"config,omitempty"`
-	Value  *string             `json:"value,omitempty"`
-}
-
-// MetadataDTO_Config defines model for MetadataDTO.Config.
-type MetadataDTO_Config struct {
-	AdditionalProperties map[string]map[string]interface{} `json:"-"`
+	Config *map[string]interface{} `json:"config,omitempty"`
+	Value  *string                 `json:"value,omitempty"`
 }
 
 // Module defines model for Module.
@@ -910,7 +872,7 @@ type RuleDTO struct {
 	Actions            *[]ActionDTO                     `json:"actions,omitempty"`
 	Conditions         *[]ConditionDTO                  `json:"conditions,omitempty"`
 	ConfigDescriptions *[]ConfigDescriptionParameterDTO `json:"configDescriptions,omitempty"`
-	Configuration      *RuleDTO_Configuration           `json:"configuration,omitempty"`
+	Configuration      *map[string]interface{}          `json:"configuration,omitempty"`
 	Description        *string                          `json:"description,omitempty"`
 	Name               *string                          `json:"name,omitempty"`
 	Tags               *[]string                        `json:"tags,omitempty"`
@@ -920,11 +882,6 @@ type RuleDTO struct {
 	Visibility         *RuleDTOVisibility               `json:"visibility,omitempty"`
 }
 
-// RuleDTO_Configuration defines model for RuleDTO.Configuration.
-type RuleDTO_Configuration struct {
-	AdditionalProperties map[string]map[string]interface{} `json:"-"`
-}
-
 // RuleDTOVisibility defines model for RuleDTO.Visibility.
 type RuleDTOVisibility string
 
@@ -1027,18 +984,13 @@ type ThingDTO struct {
 	UID           *string                 `json:"UID,omitempty"`
 	BridgeUID     *string                 `json:"bridgeUID,omitempty"`
 	Channels      *[]ChannelDTO           `json:"channels,omitempty"`
-	Configuration *ThingDTO_Configuration `json:"configuration,omitempty"`
+	Configuration *map[string]interface{} `json:"configuration,omitempty"`
 	Label         *string                 `json:"label,omitempty"`
 	Location      *string                 `json:"location,omitempty"`
 	Properties    *ThingDTO_Properties    `json:"properties,omitempty"`
 	ThingTypeUID  *string                 `json:"thingTypeUID,omitempty"`
 }
 
-// ThingDTO_Configuration defines model for ThingDTO.Configuration.
-type ThingDTO_Configuration struct {
-	AdditionalProperties map[string]map[string]interface{} `json:"-"`
-}
-
 // ThingDTO_Properties defines model for ThingDTO.Properties.
 type ThingDTO_Properties struct {
 	AdditionalProperties map[string]string `json:"-"`
@@ -1108,16 +1060,11 @@ type Trigger struct {
 
 // TriggerDTO defines model for TriggerDTO.
 type TriggerDTO struct {
-	Configuration *TriggerDTO_Configuration `json:"configuration,omitempty"`
-	Description   *string                   `json:"description,omitempty"`
-	Id            *string                   `json:"id,omitempty"`
-	Label         *string                   `json:"label,omitempty"`
-	Type          *string                   `json:"type,omitempty"`
-}
-
-// TriggerDTO_Configuration defines model for TriggerDTO.Configuration.
-type TriggerDTO_Configuration struct {
-	AdditionalProperties map[string]map[string]interface{} `json:"-"`
+	Configuration *map[string]interface{} `json:"configuration,omitempty"`
+	Description   *string                 `json:"description,omitempty"`
+	Id            *string                 `json:"id,omitempty"`
+	Label         *string                 `json:"label,omitempty"`
+	Type          *string                 `json:"type,omitempty"`
 }
 
 // UIComponent defines model for UIComponent.
@@ -2038,59 +1985,6 @@ func (a Action_Inputs) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for ActionDTO_Configuration. Returns the specified
-// element and whether it was found
-func (a ActionDTO_Configuration) Get(fieldName string) (value map[string]interface{}, found bool) {
-	if a.AdditionalProperties != nil {
-		value, found = a.AdditionalProperties[fieldName]
-	}
-	return
-}
-
-// Setter for additional properties for ActionDTO_Configuration
-func (a *ActionDTO_Configuration) Set(fieldName string, value map[string]interface{}) {
-	if a.AdditionalProperties == nil {
-		a.AdditionalProperties = make(map[string]map[string]interface{})
-	}
-	a.AdditionalProperties[fieldName] = value
-}
-
-// Override default JSON handling for ActionDTO_Configuration to handle AdditionalProperties
-func (a *ActionDTO_Configuration) UnmarshalJSON(b []byte) error {
-	object := make(map[string]json.RawMessage)
-	err := json.Unmarshal(b, &object)
-	if err != nil {
-		return err
-	}
-
-	if len(object) != 0 {
-		a.AdditionalProperties = make(map[string]map[string]interface{})
-		for fieldName, fieldBuf := range object {
-			var fieldVal map[string]interface{}
-			err := json.Unmarshal(fieldBuf, &fieldVal)
-			if err != nil {
-				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
-			}
-			a.AdditionalProperties[fieldName] = fieldVal
-		}
-	}
-	return nil
-}
-
-// Override default JSON handling for ActionDTO_Configuration to handle AdditionalProperties
-func (a ActionDTO_Configuration) MarshalJSON() ([]byte, error) {
-	var err error
-	object := make(map[string]json.RawMessage)
-
-	for fieldName, field := range a.AdditionalProperties {
-		object[fieldName], err = json.Marshal(field)
-		if err != nil {
-			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
-		}
-	}
-	return json.Marshal(object)
-}
-
 // Getter for additional properties for ActionDTO_Inputs. Returns the specified
 // element and whether it was found
 func (a ActionDTO_Inputs) Get(fieldName string) (value string, found bool) {
@@ -2108,485 +2002,8 @@ func (a *ActionDTO_Inputs) Set(fieldName string, value string) {
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for ActionDTO_Inputs to handle AdditionalProperties
-func (a *ActionDTO_Inputs) UnmarshalJSON(b []byte) error {
-	object := make(map[string]json.RawMessage)
-	err := json.Unmarshal(b, &object)
-	if err != nil {
-		return err
-	}
-
-	if len(object) != 0 {
-		a.AdditionalProperties = make(map[string]string)
-		for fieldName, fieldBuf := range object {
-			var fieldVal string
-			err := json.Unmarshal(fieldBuf, &fieldVal)
-			if err != nil {
-				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
-			}
-			a.AdditionalProperties[fieldName] = fieldVal
-		}
-	}
-	return nil
-}
-
-// Override default JSON handling for ActionDTO_Inputs to handle AdditionalProperties
-func (a ActionDTO_Inputs) MarshalJSON() ([]byte, error) {
-	var err error
-	object := make(map[string]json.RawMessage)
-
-	for fieldName, field := range a.AdditionalProperties {
-		object[fieldName], err = json.Marshal(field)
-		if err != nil {
-			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
-		}
-	}
-	return json.Marshal(object)
-}
-
-// Getter for additional properties for Addon_Properties. Returns the specified
-// element and whether it was found
-func (a Addon_Properties) Get(fieldName string) (value map[string]interface{}, found bool) {
-	if a.AdditionalProperties != nil {
-		value, found = a.AdditionalProperties[fieldName]
-	}
-	return
-}
-
-// Setter for additional properties for Addon_Properties
-func (a *Addon_Properties) Set(fieldName string, value map[string]interface{}) {
-	if a.AdditionalProperties == nil {
-		a.AdditionalProperties = make(map[string]map[string]interface{})
-	}
-	a.AdditionalProperties[fieldName] = value
-}
-
-// Override default JSON handling for Addon_Properties to handle AdditionalProperties
-func (a *Addon_Properties) UnmarshalJSON(b []byte) error {
-	object := make(map[string]json.RawMessage)
-	err := json.Unmarshal(b, &object)
-	if err != nil {
-		return err
-	}
-
-	if len(object) != 0 {
-		a.AdditionalProperties = make(map[string]map[string]interface{})
-		for fieldName, fieldBuf := range object {
-			var fieldVal map[string]interface{}
-			err := json.Unmarshal(fieldBuf, &fieldVal)
-			if err != nil {
-				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
-			}
-			a.AdditionalProperties[fieldName] = fieldVal
-		}
-	}
-	return nil
-}
-
-// Override default JSON handling for Addon_Properties to handle AdditionalProperties
-func (a Addon_Properties) MarshalJSON() ([]byte, error) {
-	var err error
-	object := make(map[string]json.RawMessage)
-
-	for fieldName, field := range a.AdditionalProperties {
-		object[fieldName], err = json.Marshal(field)
-		if err != nil {
-			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
-		}
-	}
-	return json.Marshal(object)
-}
-
-// Getter for additional properties for ChannelDTO_Configuration. Returns the specified
-// element and whether it was found
-func (a ChannelDTO_Configuration) Get(fieldName string) (value map[string]interface{}, found bool) {
-	if a.AdditionalProperties != nil {
-		value, found = a.AdditionalProperties[fieldName]
-	}
-	return
-}
-
-// Setter for additional properties for ChannelDTO_Configuration
-func (a *ChannelDTO_Configuration) Set(fieldName string, value map[string]interface{}) {
-	if a.AdditionalProperties == nil {
-		a.AdditionalProperties = make(map[string]map[string]interface{})
-	}
-	a.AdditionalProperties[fieldName] = value
-}
-
-// Override default JSON handling for ChannelDTO_Configuration to handle AdditionalProperties
-func (a *ChannelDTO_Configuration) UnmarshalJSON(b []byte) error {
-	object := make(map[string]json.RawMessage)
-	err := json.Unmarshal(b, &object)
-	if err != nil {
-		return err
-	}
-
-	if len(object) != 0 {
-		a.AdditionalProperties = make(map[string]map[string]interface{})
-		for fieldName, fieldBuf := range object {
-			var fieldVal map[string]interface{}
-			err := json.Unmarshal(fieldBuf, &fieldVal)
-			if err != nil {
-				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
-			}
-			a.AdditionalProperties[fieldName] = fieldVal
-		}
-	}
-	return nil
-}
-
-// Override default JSON handling for ChannelDTO_Configuration to handle AdditionalProperties
-func (a ChannelDTO_Configuration) MarshalJSON() ([]byte, error) {
-	var err error
-	object := make(map[string]json.RawMessage)
-
-	for fieldName, field := range a.AdditionalProperties {
-		object[fieldName], err = json.Marshal(field)
-		if err != nil {
-			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
-		}
-	}
-	return json.Marshal(object)
-}
-
-// Getter for additional properties for ChannelDTO_Properties. Returns the specified
-// element and whether it was found
-func (a ChannelDTO_Properties) Get(fieldName string) (value string, found bool) {
-	if a.AdditionalProperties != nil {
-		value, found = a.AdditionalProperties[fieldName]
-	}
-	return
-}
-
-// Setter for additional properties for ChannelDTO_Properties
-func (a *ChannelDTO_Properties) Set(fieldName string, value string) {
-	if a.AdditionalProperties == nil {
-		a.AdditionalProperties = make(map[string]string)
-	}
-	a.AdditionalProperties[fieldName] = value
-}
-
-// Override default JSON handling for ChannelDTO_Properties to handle AdditionalProperties
-func (a *ChannelDTO_Properties) UnmarshalJSON(b []byte) error {
-	object := make(map[string]json.RawMessage)
-	err := json.Unmarshal(b, &object)
-	if err != nil {
-		return err
-	}
-
-	if len(object) != 0 {
-		a.AdditionalProperties = make(map[string]string)
-		for fieldName, fieldBuf := range object {
-			var fieldVal string
-			err := json.Unmarshal(fieldBuf, &fieldVal)
-			if err != nil {
-				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
-			}
-			a.AdditionalProperties[fieldName] = fieldVal
-		}
-	}
-	return nil
-}
-
-// Override default JSON handling for ChannelDTO_Properties to handle AdditionalProperties
-func (a ChannelDTO_Properties) MarshalJSON() ([]byte, error) {
-	var err error
-	object := make(map[string]json.RawMessage)
-
-	for fieldName, field := range a.AdditionalProperties {
-		object[fieldName], err = json.Marshal(field)
-		if err != nil {
-			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
-		}
-	}
-	return json.Marshal(object)
-}
-
-// Getter for additional properties for ChannelDefinitionDTO_Properties. Returns the specified
-// element and whether it was found
-func (a ChannelDefinitionDTO_Properties) Get(fieldName string) (value string, found bool) {
-	if a.AdditionalProperties != nil {
-		value, found = a.AdditionalProperties[fieldName]
-	}
-	return
-}
-
-// Setter for additional properties for ChannelDefinitionDTO_Properties
-func (a *ChannelDefinitionDTO_Properties) Set(fieldName string, value string) {
-	if a.AdditionalProperties == nil {
-		a.AdditionalProperties = make(map[string]string)
-	}
-	a.AdditionalProperties[fieldName] = value
-}
-
-// Override default JSON handling for ChannelDefinitionDTO_Properties to handle AdditionalProperties
-func (a *ChannelDefinitionDTO_Properties) UnmarshalJSON(b []byte) error {
-	object := make(map[string]json.RawMessage)
-	err := json.Unmarshal(b, &object)
-	if err != nil {
-		return err
-	}
-
-	if len(object) != 0 {
-		a.AdditionalProperties = make(map[string]string)
-		for fieldName, fieldBuf := range object {
-			var fieldVal string
-			err := json.Unmarshal(fieldBuf, &fieldVal)
-			if err != nil {
-				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
-			}
-			a.AdditionalProperties[fieldName] = fieldVal
-		}
-	}
-	return nil
-}
-
-// Override default JSON handling for ChannelDefinitionDTO_Properties to handle AdditionalProperties
-func (a ChannelDefinitionDTO_Properties) MarshalJSON() ([]byte, error) {
-	var err error
-	object := make(map[string]json.RawMessage)
-
-	for fieldName, field := range a.AdditionalProperties {
-		object[fieldName], err = json.Marshal(field)
-		if err != nil {
-			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
-		}
-	}
-	return json.Marshal(object)
-}
-
-// Getter for additional properties for Condition_Inputs. Returns the specified
-// element and whether it was found
-func (a Condition_Inputs) Get(fieldName string) (value string, found bool) {
-	if a.AdditionalProperties != nil {
-		value, found = a.AdditionalProperties[fieldName]
-	}
-	return
-}
-
-// Setter for additional properties for Condition_Inputs
-func (a *Condition_Inputs) Set(fieldName string, value string) {
-	if a.AdditionalProperties == nil {
-		a.AdditionalProperties = make(map[string]string)
-	}
-	a.AdditionalProperties[fieldName] = value
-}
-
-// Override default JSON handling for Condition_Inputs to handle AdditionalProperties
-func (a *Condition_Inputs) UnmarshalJSON(b []byte) error {
-	object := make(map[string]json.RawMessage)
-	err := json.Unmarshal(b, &object)
-	if err != nil {
-		return err
-	}
-
-	if len(object) != 0 {
-		a.AdditionalProperties = make(map[string]string)
-		for fieldName, fieldBuf := range object {
-			var fieldVal string
-			err := json.Unmarshal(fieldBuf, &fieldVal)
-			if err != nil {
-				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
-			}
-			a.AdditionalProperties[fieldName] = fieldVal
-		}
-	}
-	return nil
-}
-
-// Override default JSON handling for Condition_Inputs to handle AdditionalProperties
-func (a Condition_Inputs) MarshalJSON() ([]byte, error) {
-	var err error
-	object := make(map[string]json.RawMessage)
-
-	for fieldName, field := range a.AdditionalProperties {
-		object[fieldName], err = json.Marshal(field)
-		if err != nil {
-			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
-		}
-	}
-	return json.Marshal(object)
-}
-
-// Getter for additional properties for ConditionDTO_Configuration. Returns the specified
-// element and whether it was found
-func (a ConditionDTO_Configuration) Get(fieldName string) (value map[string]interface{}, found bool) {
-	if a.AdditionalProperties != nil {
-		value, found = a.AdditionalProperties[fieldName]
-	}
-	return
-}
-
-// Setter for additional properties for ConditionDTO_Configuration
-func (a *ConditionDTO_Configuration) Set(fieldName string, value map[string]interface{}) {
-	if a.AdditionalProperties == nil {
-		a.AdditionalProperties = make(map[string]map[string]interface{})
-	}
-	a.AdditionalProperties[fieldName] = value
-}
-
-// Override default JSON handling for ConditionDTO_Configuration to handle AdditionalProperties
-func (a *ConditionDTO_Configuration) UnmarshalJSON(b []byte) error {
-	object := make(map[string]json.RawMessage)
-	err := json.Unmarshal(b, &object)
-	if err != nil {
-		return err
-	}
-
-	if len(object) != 0 {
-		a.AdditionalProperties = make(map[string]map[string]interface{})
-		for fieldName, fieldBuf := range object {
-			var fieldVal map[string]interface{}
-			err := json.Unmarshal(fieldBuf, &fieldVal)
-			if err != nil {
-				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
-			}
-			a.AdditionalProperties[fieldName] = fieldVal
-		}
-	}
-	return nil
-}
-
-// Override default JSON handling for ConditionDTO_Configuration to handle AdditionalProperties
-func (a ConditionDTO_Configuration) MarshalJSON() ([]byte, error) {
-	var err error
-	object := make(map[string]json.RawMessage)
-
-	for fieldName, field := range a.AdditionalProperties {
-		object[fieldName], err = json.Marshal(field)
-		if err != nil {
-			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
-		}
-	}
-	return json.Marshal(object)
-}
-
-// Getter for additional properties for ConditionDTO_Inputs. Returns the specified
-// element and whether it was found
-func (a ConditionDTO_Inputs) Get(fieldName string) (value string, found bool) {
-	if a.AdditionalProperties != nil {
-		value, found = a.AdditionalProperties[fieldName]
-	}
-	return
-}
-
-// Setter for additional properties for ConditionDTO_Inputs
-func (a *ConditionDTO_Inputs) Set(fieldName string, value string) {
-	if a.AdditionalProperties == nil {
-		a.AdditionalProperties = make(map[string]string)
-	}
-	a.AdditionalProperties[fieldName] = value
-}
-
-// Override default JSON handling for ConditionDTO_Inputs to handle AdditionalProperties
-func (a *ConditionDTO_Inputs) UnmarshalJSON(b []byte) error {
-	object := make(map[string]json.RawMessage)
-	err := json.Unmarshal(b, &object)
-	if err != nil {
-		return err
-	}
-
-	if len(object) != 0 {
-		a.AdditionalProperties = make(map[string]string)
-		for fieldName, fieldBuf := range object {
-			var fieldVal string
-			err := json.Unmarshal(fieldBuf, &fieldVal)
-			if err != nil {
-				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
-			}
-			a.AdditionalProperties[fieldName] = fieldVal
-		}
-	}
-	return nil
-}
-
-// Override default JSON handling for ConditionDTO_Inputs to handle AdditionalProperties
-func (a ConditionDTO_Inputs) MarshalJSON() ([]byte, error) {
-	var err error
-	object := make(map[string]json.RawMessage)
-
-	for fieldName, field := range a.AdditionalProperties {
-		object[fieldName], err = json.Marshal(field)
-		if err != nil {
-			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
-		}
-	}
-	return json.Marshal(object)
-}
-
-// Getter for additional properties for Configuration_Properties. Returns the specified
-// element and whether it was found
-func (a Configuration_Properties) Get(fieldName string) (value map[string]interface{}, found bool) {
-	if a.AdditionalProperties != nil {
-		value, found = a.AdditionalProperties[fieldName]
-	}
-	return
-}
-
-// Setter for additional properties for Configuration_Properties
-func (a *Configuration_Properties) Set(fieldName string, value map[string]interface{}) {
-	if a.AdditionalProperties == nil {
-		a.AdditionalProperties = make(map[string]map[string]interface{})
-	}
-	a.AdditionalProperties[fieldName] = value
-}
-
-// Override default JSON handling for Configuration_Properties to handle AdditionalProperties
-func (a *Configuration_Properties) UnmarshalJSON(b []byte) error {
-	object := make(map[string]json.RawMessage)
-	err := json.Unmarshal(b, &object)
-	if err != nil {
-		return err
-	}
-
-	if len(object) != 0 {
-		a.AdditionalProperties = make(map[string]map[string]interface{})
-		for fieldName, fieldBuf := range object {
-			var fieldVal map[string]interface{}
-			err := json.Unmarshal(fieldBuf, &fieldVal)
-			if err != nil {
-				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
-			}
-			a.AdditionalProperties[fieldName] = fieldVal
-		}
-	}
-	return nil
-}
-
-// Override default JSON handling for Configuration_Properties to handle AdditionalProperties
-func (a Configuration_Properties) MarshalJSON() ([]byte, error) {
-	var err error
-	object := make(map[string]json.RawMessage)
-
-	for fieldName, field := range a.AdditionalProperties {
-		object[fieldName], err = json.Marshal(field)
-		if err != nil {
-			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
-		}
-	}
-	return json.Marshal(object)
-}
-
-// Getter for additional properties for DiscoveryResultDTO_Properties. Returns the specified
-// element and whether it was found
-func (a DiscoveryResultDTO_Properties) Get(fieldName string) (value map[string]interface{}, found bool) {
-	if a.AdditionalProperties != nil {
-		value, found = a.AdditionalProperties[fieldName]
-	}
-	return
-}
-
-// Setter for additional properties for DiscoveryResultDTO_Properties
-func (a *DiscoveryResultDTO_Properties) Set(fieldName string, value map[string]interface{}) {
-	if a.AdditionalProperties == nil {
-		a.AdditionalProperties = make(map[string]map[string]interface{})
-	}
-	a.AdditionalProperties[fieldName] = value
-}
-
-// Override default JSON handling for DiscoveryResultDTO_Properties to handle AdditionalProperties
-func (a *DiscoveryResultDTO_Properties) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for ActionDTO_Inputs to handle AdditionalProperties
+func (a *ActionDTO_Inputs) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
@@ -2594,9 +2011,9 @@ func (a *DiscoveryResultDTO_Properties) UnmarshalJSON(b []byte) error {
 	}
 
 	if len(object) != 0 {
-		a.AdditionalProperties = make(map[string]map[string]interface{})
+		a.AdditionalProperties = make(map[string]string)
 		for fieldName, fieldBuf := range object {
-			var fieldVal map[string]interface{}
+			var fieldVal string
 			err := json.Unmarshal(fieldBuf, &fieldVal)
 			if err != nil {
 				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
@@ -2607,8 +2024,8 @@ func (a *DiscoveryResultDTO_Properties) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// Override default JSON handling for DiscoveryResultDTO_Properties to handle AdditionalProperties
-func (a DiscoveryResultDTO_Properties) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for ActionDTO_Inputs to handle AdditionalProperties
+func (a ActionDTO_Inputs) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
@@ -2621,25 +2038,25 @@ func (a DiscoveryResultDTO_Properties) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for EnrichedChannelDTO_Configuration. Returns the specified
+// Getter for additional properties for Addon_Properties. Returns the specified
 // element and whether it was found
-func (a EnrichedChannelDTO_Configuration) Get(fieldName string) (value map[string]interface{}, found bool) {
+func (a Addon_Properties) Get(fieldName string) (value map[string]interface{}, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for EnrichedChannelDTO_Configuration
-func (a *EnrichedChannelDTO_Configuration) Set(fieldName string, value map[string]interface{}) {
+// Setter for additional properties for Addon_Properties
+func (a *Addon_Properties) Set(fieldName string, value map[string]interface{}) {
 	if a.AdditionalProperties == nil {
 		a.AdditionalProperties = make(map[string]map[string]interface{})
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for EnrichedChannelDTO_Configuration to handle AdditionalProperties
-func (a *EnrichedChannelDTO_Configuration) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for Addon_Properties to handle AdditionalProperties
+func (a *Addon_Properties) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
@@ -2660,8 +2077,8 @@ func (a *EnrichedChannelDTO_Configuration) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// Override default JSON handling for EnrichedChannelDTO_Configuration to handle AdditionalProperties
-func (a EnrichedChannelDTO_Configuration) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for Addon_Properties to handle AdditionalProperties
+func (a Addon_Properties) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
@@ -2674,25 +2091,25 @@ func (a EnrichedChannelDTO_Configuration) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for EnrichedChannelDTO_Properties. Returns the specified
+// Getter for additional properties for ChannelDTO_Properties. Returns the specified
 // element and whether it was found
-func (a EnrichedChannelDTO_Properties) Get(fieldName string) (value string, found bool) {
+func (a ChannelDTO_Properties) Get(fieldName string) (value string, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for EnrichedChannelDTO_Properties
-func (a *EnrichedChannelDTO_Properties) Set(fieldName string, value string) {
+// Setter for additional properties for ChannelDTO_Properties
+func (a *ChannelDTO_Properties) Set(fieldName string, value string) {
 	if a.AdditionalProperties == nil {
 		a.AdditionalProperties = make(map[string]string)
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for EnrichedChannelDTO_Properties to handle AdditionalProperties
-func (a *EnrichedChannelDTO_Properties) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for ChannelDTO_Properties to handle AdditionalProperties
+func (a *ChannelDTO_Properties) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
@@ -2713,8 +2130,8 @@ func (a *EnrichedChannelDTO_Properties) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// Override default JSON handling for EnrichedChannelDTO_Properties to handle AdditionalProperties
-func (a EnrichedChannelDTO_Properties) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for ChannelDTO_Properties to handle AdditionalProperties
+func (a ChannelDTO_Properties) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
@@ -2727,25 +2144,25 @@ func (a EnrichedChannelDTO_Properties) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for EnrichedItemChannelLinkDTO_Configuration. Returns the specified
+// Getter for additional properties for ChannelDefinitionDTO_Properties. Returns the specified
 // element and whether it was found
-func (a EnrichedItemChannelLinkDTO_Configuration) Get(fieldName string) (value map[string]interface{}, found bool) {
+func (a ChannelDefinitionDTO_Properties) Get(fieldName string) (value string, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for EnrichedItemChannelLinkDTO_Configuration
-func (a *EnrichedItemChannelLinkDTO_Configuration) Set(fieldName string, value map[string]interface{}) {
+// Setter for additional properties for ChannelDefinitionDTO_Properties
+func (a *ChannelDefinitionDTO_Properties) Set(fieldName string, value string) {
 	if a.AdditionalProperties == nil {
-		a.AdditionalProperties = make(map[string]map[string]interface{})
+		a.AdditionalProperties = make(map[string]string)
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for EnrichedItemChannelLinkDTO_Configuration to handle AdditionalProperties
-func (a *EnrichedItemChannelLinkDTO_Configuration) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for ChannelDefinitionDTO_Properties to handle AdditionalProperties
+func (a *ChannelDefinitionDTO_Properties) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
@@ -2753,9 +2170,9 @@ func (a *EnrichedItemChannelLinkDTO_Configuration) UnmarshalJSON(b []byte) error
 	}
 
 	if len(object) != 0 {
-		a.AdditionalProperties = make(map[string]map[string]interface{})
+		a.AdditionalProperties = make(map[string]string)
 		for fieldName, fieldBuf := range object {
-			var fieldVal map[string]interface{}
+			var fieldVal string
 			err := json.Unmarshal(fieldBuf, &fieldVal)
 			if err != nil {
 				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
@@ -2766,8 +2183,8 @@ func (a *EnrichedItemChannelLinkDTO_Configuration) UnmarshalJSON(b []byte) error
 	return nil
 }
 
-// Override default JSON handling for EnrichedItemChannelLinkDTO_Configuration to handle AdditionalProperties
-func (a EnrichedItemChannelLinkDTO_Configuration) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for ChannelDefinitionDTO_Properties to handle AdditionalProperties
+func (a ChannelDefinitionDTO_Properties) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
@@ -2780,25 +2197,25 @@ func (a EnrichedItemChannelLinkDTO_Configuration) MarshalJSON() ([]byte, error)
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for EnrichedItemDTO_Metadata. Returns the specified
+// Getter for additional properties for Condition_Inputs. Returns the specified
 // element and whether it was found
-func (a EnrichedItemDTO_Metadata) Get(fieldName string) (value map[string]interface{}, found bool) {
+func (a Condition_Inputs) Get(fieldName string) (value string, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for EnrichedItemDTO_Metadata
-func (a *EnrichedItemDTO_Metadata) Set(fieldName string, value map[string]interface{}) {
+// Setter for additional properties for Condition_Inputs
+func (a *Condition_Inputs) Set(fieldName string, value string) {
 	if a.AdditionalProperties == nil {
-		a.AdditionalProperties = make(map[string]map[string]interface{})
+		a.AdditionalProperties = make(map[string]string)
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for EnrichedItemDTO_Metadata to handle AdditionalProperties
-func (a *EnrichedItemDTO_Metadata) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for Condition_Inputs to handle AdditionalProperties
+func (a *Condition_Inputs) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
@@ -2806,9 +2223,9 @@ func (a *EnrichedItemDTO_Metadata) UnmarshalJSON(b []byte) error {
 	}
 
 	if len(object) != 0 {
-		a.AdditionalProperties = make(map[string]map[string]interface{})
+		a.AdditionalProperties = make(map[string]string)
 		for fieldName, fieldBuf := range object {
-			var fieldVal map[string]interface{}
+			var fieldVal string
 			err := json.Unmarshal(fieldBuf, &fieldVal)
 			if err != nil {
 				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
@@ -2819,8 +2236,8 @@ func (a *EnrichedItemDTO_Metadata) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// Override default JSON handling for EnrichedItemDTO_Metadata to handle AdditionalProperties
-func (a EnrichedItemDTO_Metadata) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for Condition_Inputs to handle AdditionalProperties
+func (a Condition_Inputs) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
@@ -2833,25 +2250,25 @@ func (a EnrichedItemDTO_Metadata) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for EnrichedRuleDTO_Configuration. Returns the specified
+// Getter for additional properties for ConditionDTO_Inputs. Returns the specified
 // element and whether it was found
-func (a EnrichedRuleDTO_Configuration) Get(fieldName string) (value map[string]interface{}, found bool) {
+func (a ConditionDTO_Inputs) Get(fieldName string) (value string, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for EnrichedRuleDTO_Configuration
-func (a *EnrichedRuleDTO_Configuration) Set(fieldName string, value map[string]interface{}) {
+// Setter for additional properties for ConditionDTO_Inputs
+func (a *ConditionDTO_Inputs) Set(fieldName string, value string) {
 	if a.AdditionalProperties == nil {
-		a.AdditionalProperties = make(map[string]map[string]interface{})
+		a.AdditionalProperties = make(map[string]string)
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for EnrichedRuleDTO_Configuration to handle AdditionalProperties
-func (a *EnrichedRuleDTO_Configuration) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for ConditionDTO_Inputs to handle AdditionalProperties
+func (a *ConditionDTO_Inputs) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
@@ -2859,9 +2276,9 @@ func (a *EnrichedRuleDTO_Configuration) UnmarshalJSON(b []byte) error {
 	}
 
 	if len(object) != 0 {
-		a.AdditionalProperties = make(map[string]map[string]interface{})
+		a.AdditionalProperties = make(map[string]string)
 		for fieldName, fieldBuf := range object {
-			var fieldVal map[string]interface{}
+			var fieldVal string
 			err := json.Unmarshal(fieldBuf, &fieldVal)
 			if err != nil {
 				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
@@ -2872,8 +2289,8 @@ func (a *EnrichedRuleDTO_Configuration) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// Override default JSON handling for EnrichedRuleDTO_Configuration to handle AdditionalProperties
-func (a EnrichedRuleDTO_Configuration) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for ConditionDTO_Inputs to handle AdditionalProperties
+func (a ConditionDTO_Inputs) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
@@ -2886,25 +2303,25 @@ func (a EnrichedRuleDTO_Configuration) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for EnrichedThingDTO_Configuration. Returns the specified
+// Getter for additional properties for Configuration_Properties. Returns the specified
 // element and whether it was found
-func (a EnrichedThingDTO_Configuration) Get(fieldName string) (value map[string]interface{}, found bool) {
+func (a Configuration_Properties) Get(fieldName string) (value map[string]interface{}, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for EnrichedThingDTO_Configuration
-func (a *EnrichedThingDTO_Configuration) Set(fieldName string, value map[string]interface{}) {
+// Setter for additional properties for Configuration_Properties
+func (a *Configuration_Properties) Set(fieldName string, value map[string]interface{}) {
 	if a.AdditionalProperties == nil {
 		a.AdditionalProperties = make(map[string]map[string]interface{})
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for EnrichedThingDTO_Configuration to handle AdditionalProperties
-func (a *EnrichedThingDTO_Configuration) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for Configuration_Properties to handle AdditionalProperties
+func (a *Configuration_Properties) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
@@ -2925,8 +2342,8 @@ func (a *EnrichedThingDTO_Configuration) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// Override default JSON handling for EnrichedThingDTO_Configuration to handle AdditionalProperties
-func (a EnrichedThingDTO_Configuration) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for Configuration_Properties to handle AdditionalProperties
+func (a Configuration_Properties) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
@@ -2939,25 +2356,25 @@ func (a EnrichedThingDTO_Configuration) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for EnrichedThingDTO_Properties. Returns the specified
+// Getter for additional properties for DiscoveryResultDTO_Properties. Returns the specified
 // element and whether it was found
-func (a EnrichedThingDTO_Properties) Get(fieldName string) (value string, found bool) {
+func (a DiscoveryResultDTO_Properties) Get(fieldName string) (value map[string]interface{}, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for EnrichedThingDTO_Properties
-func (a *EnrichedThingDTO_Properties) Set(fieldName string, value string) {
+// Setter for additional properties for DiscoveryResultDTO_Properties
+func (a *DiscoveryResultDTO_Properties) Set(fieldName string, value map[string]interface{}) {
 	if a.AdditionalProperties == nil {
-		a.AdditionalProperties = make(map[string]string)
+		a.AdditionalProperties = make(map[string]map[string]interface{})
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for EnrichedThingDTO_Properties to handle AdditionalProperties
-func (a *EnrichedThingDTO_Properties) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for DiscoveryResultDTO_Properties to handle AdditionalProperties
+func (a *DiscoveryResultDTO_Properties) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
@@ -2965,9 +2382,9 @@ func (a *EnrichedThingDTO_Properties) UnmarshalJSON(b []byte) error {
 	}
 
 	if len(object) != 0 {
-		a.AdditionalProperties = make(map[string]string)
+		a.AdditionalProperties = make(map[string]map[string]interface{})
 		for fieldName, fieldBuf := range object {
-			var fieldVal string
+			var fieldVal map[string]interface{}
 			err := json.Unmarshal(fieldBuf, &fieldVal)
 			if err != nil {
 				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
@@ -2978,8 +2395,8 @@ func (a *EnrichedThingDTO_Properties) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// Override default JSON handling for EnrichedThingDTO_Properties to handle AdditionalProperties
-func (a EnrichedThingDTO_Properties) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for DiscoveryResultDTO_Properties to handle AdditionalProperties
+func (a DiscoveryResultDTO_Properties) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
@@ -2992,25 +2409,25 @@ func (a EnrichedThingDTO_Properties) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for MetadataDTO_Config. Returns the specified
+// Getter for additional properties for EnrichedChannelDTO_Properties. Returns the specified
 // element and whether it was found
-func (a MetadataDTO_Config) Get(fieldName string) (value map[string]interface{}, found bool) {
+func (a EnrichedChannelDTO_Properties) Get(fieldName string) (value string, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for MetadataDTO_Config
-func (a *MetadataDTO_Config) Set(fieldName string, value map[string]interface{}) {
+// Setter for additional properties for EnrichedChannelDTO_Properties
+func (a *EnrichedChannelDTO_Properties) Set(fieldName string, value string) {
 	if a.AdditionalProperties == nil {
-		a.AdditionalProperties = make(map[string]map[string]interface{})
+		a.AdditionalProperties = make(map[string]string)
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for MetadataDTO_Config to handle AdditionalProperties
-func (a *MetadataDTO_Config) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for EnrichedChannelDTO_Properties to handle AdditionalProperties
+func (a *EnrichedChannelDTO_Properties) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
@@ -3018,9 +2435,9 @@ func (a *MetadataDTO_Config) UnmarshalJSON(b []byte) error {
 	}
 
 	if len(object) != 0 {
-		a.AdditionalProperties = make(map[string]map[string]interface{})
+		a.AdditionalProperties = make(map[string]string)
 		for fieldName, fieldBuf := range object {
-			var fieldVal map[string]interface{}
+			var fieldVal string
 			err := json.Unmarshal(fieldBuf, &fieldVal)
 			if err != nil {
 				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
@@ -3031,8 +2448,8 @@ func (a *MetadataDTO_Config) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// Override default JSON handling for MetadataDTO_Config to handle AdditionalProperties
-func (a MetadataDTO_Config) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for EnrichedChannelDTO_Properties to handle AdditionalProperties
+func (a EnrichedChannelDTO_Properties) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
@@ -3045,25 +2462,25 @@ func (a MetadataDTO_Config) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for ModuleDTO_Configuration. Returns the specified
+// Getter for additional properties for EnrichedItemDTO_Metadata. Returns the specified
 // element and whether it was found
-func (a ModuleDTO_Configuration) Get(fieldName string) (value map[string]interface{}, found bool) {
+func (a EnrichedItemDTO_Metadata) Get(fieldName string) (value map[string]interface{}, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for ModuleDTO_Configuration
-func (a *ModuleDTO_Configuration) Set(fieldName string, value map[string]interface{}) {
+// Setter for additional properties for EnrichedItemDTO_Metadata
+func (a *EnrichedItemDTO_Metadata) Set(fieldName string, value map[string]interface{}) {
 	if a.AdditionalProperties == nil {
 		a.AdditionalProperties = make(map[string]map[string]interface{})
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for ModuleDTO_Configuration to handle AdditionalProperties
-func (a *ModuleDTO_Configuration) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for EnrichedItemDTO_Metadata to handle AdditionalProperties
+func (a *EnrichedItemDTO_Metadata) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
@@ -3084,8 +2501,8 @@ func (a *ModuleDTO_Configuration) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// Override default JSON handling for ModuleDTO_Configuration to handle AdditionalProperties
-func (a ModuleDTO_Configuration) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for EnrichedItemDTO_Metadata to handle AdditionalProperties
+func (a EnrichedItemDTO_Metadata) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
@@ -3098,25 +2515,25 @@ func (a ModuleDTO_Configuration) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for RootUIComponent_Config. Returns the specified
+// Getter for additional properties for EnrichedThingDTO_Properties. Returns the specified
 // element and whether it was found
-func (a RootUIComponent_Config) Get(fieldName string) (value map[string]interface{}, found bool) {
+func (a EnrichedThingDTO_Properties) Get(fieldName string) (value string, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for RootUIComponent_Config
-func (a *RootUIComponent_Config) Set(fieldName string, value map[string]interface{}) {
+// Setter for additional properties for EnrichedThingDTO_Properties
+func (a *EnrichedThingDTO_Properties) Set(fieldName string, value string) {
 	if a.AdditionalProperties == nil {
-		a.AdditionalProperties = make(map[string]map[string]interface{})
+		a.AdditionalProperties = make(map[string]string)
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for RootUIComponent_Config to handle AdditionalProperties
-func (a *RootUIComponent_Config) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for EnrichedThingDTO_Properties to handle AdditionalProperties
+func (a *EnrichedThingDTO_Properties) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
@@ -3124,9 +2541,9 @@ func (a *RootUIComponent_Config) UnmarshalJSON(b []byte) error {
 	}
 
 	if len(object) != 0 {
-		a.AdditionalProperties = make(map[string]map[string]interface{})
+		a.AdditionalProperties = make(map[string]string)
 		for fieldName, fieldBuf := range object {
-			var fieldVal map[string]interface{}
+			var fieldVal string
 			err := json.Unmarshal(fieldBuf, &fieldVal)
 			if err != nil {
 				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
@@ -3137,8 +2554,8 @@ func (a *RootUIComponent_Config) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// Override default JSON handling for RootUIComponent_Config to handle AdditionalProperties
-func (a RootUIComponent_Config) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for EnrichedThingDTO_Properties to handle AdditionalProperties
+func (a EnrichedThingDTO_Properties) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
@@ -3151,25 +2568,25 @@ func (a RootUIComponent_Config) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for RootUIComponent_Slots. Returns the specified
+// Getter for additional properties for ModuleDTO_Configuration. Returns the specified
 // element and whether it was found
-func (a RootUIComponent_Slots) Get(fieldName string) (value []UIComponent, found bool) {
+func (a ModuleDTO_Configuration) Get(fieldName string) (value map[string]interface{}, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for RootUIComponent_Slots
-func (a *RootUIComponent_Slots) Set(fieldName string, value []UIComponent) {
+// Setter for additional properties for ModuleDTO_Configuration
+func (a *ModuleDTO_Configuration) Set(fieldName string, value map[string]interface{}) {
 	if a.AdditionalProperties == nil {
-		a.AdditionalProperties = make(map[string][]UIComponent)
+		a.AdditionalProperties = make(map[string]map[string]interface{})
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for RootUIComponent_Slots to handle AdditionalProperties
-func (a *RootUIComponent_Slots) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for ModuleDTO_Configuration to handle AdditionalProperties
+func (a *ModuleDTO_Configuration) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
@@ -3177,9 +2594,9 @@ func (a *RootUIComponent_Slots) UnmarshalJSON(b []byte) error {
 	}
 
 	if len(object) != 0 {
-		a.AdditionalProperties = make(map[string][]UIComponent)
+		a.AdditionalProperties = make(map[string]map[string]interface{})
 		for fieldName, fieldBuf := range object {
-			var fieldVal []UIComponent
+			var fieldVal map[string]interface{}
 			err := json.Unmarshal(fieldBuf, &fieldVal)
 			if err != nil {
 				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
@@ -3190,8 +2607,8 @@ func (a *RootUIComponent_Slots) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// Override default JSON handling for RootUIComponent_Slots to handle AdditionalProperties
-func (a RootUIComponent_Slots) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for ModuleDTO_Configuration to handle AdditionalProperties
+func (a ModuleDTO_Configuration) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
@@ -3204,25 +2621,25 @@ func (a RootUIComponent_Slots) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for RuleDTO_Configuration. Returns the specified
+// Getter for additional properties for RootUIComponent_Config. Returns the specified
 // element and whether it was found
-func (a RuleDTO_Configuration) Get(fieldName string) (value map[string]interface{}, found bool) {
+func (a RootUIComponent_Config) Get(fieldName string) (value map[string]interface{}, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for RuleDTO_Configuration
-func (a *RuleDTO_Configuration) Set(fieldName string, value map[string]interface{}) {
+// Setter for additional properties for RootUIComponent_Config
+func (a *RootUIComponent_Config) Set(fieldName string, value map[string]interface{}) {
 	if a.AdditionalProperties == nil {
 		a.AdditionalProperties = make(map[string]map[string]interface{})
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for RuleDTO_Configuration to handle AdditionalProperties
-func (a *RuleDTO_Configuration) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for RootUIComponent_Config to handle AdditionalProperties
+func (a *RootUIComponent_Config) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
@@ -3243,8 +2660,8 @@ func (a *RuleDTO_Configuration) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// Override default JSON handling for RuleDTO_Configuration to handle AdditionalProperties
-func (a RuleDTO_Configuration) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for RootUIComponent_Config to handle AdditionalProperties
+func (a RootUIComponent_Config) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
@@ -3257,25 +2674,25 @@ func (a RuleDTO_Configuration) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for ThingDTO_Configuration. Returns the specified
+// Getter for additional properties for RootUIComponent_Slots. Returns the specified
 // element and whether it was found
-func (a ThingDTO_Configuration) Get(fieldName string) (value map[string]interface{}, found bool) {
+func (a RootUIComponent_Slots) Get(fieldName string) (value []UIComponent, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for ThingDTO_Configuration
-func (a *ThingDTO_Configuration) Set(fieldName string, value map[string]interface{}) {
+// Setter for additional properties for RootUIComponent_Slots
+func (a *RootUIComponent_Slots) Set(fieldName string, value []UIComponent) {
 	if a.AdditionalProperties == nil {
-		a.AdditionalProperties = make(map[string]map[string]interface{})
+		a.AdditionalProperties = make(map[string][]UIComponent)
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for ThingDTO_Configuration to handle AdditionalProperties
-func (a *ThingDTO_Configuration) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for RootUIComponent_Slots to handle AdditionalProperties
+func (a *RootUIComponent_Slots) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
@@ -3283,9 +2700,9 @@ func (a *ThingDTO_Configuration) UnmarshalJSON(b []byte) error {
 	}
 
 	if len(object) != 0 {
-		a.AdditionalProperties = make(map[string]map[string]interface{})
+		a.AdditionalProperties = make(map[string][]UIComponent)
 		for fieldName, fieldBuf := range object {
-			var fieldVal map[string]interface{}
+			var fieldVal []UIComponent
 			err := json.Unmarshal(fieldBuf, &fieldVal)
 			if err != nil {
 				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
@@ -3296,8 +2713,8 @@ func (a *ThingDTO_Configuration) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// Override default JSON handling for ThingDTO_Configuration to handle AdditionalProperties
-func (a ThingDTO_Configuration) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for RootUIComponent_Slots to handle AdditionalProperties
+func (a RootUIComponent_Slots) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
@@ -3416,59 +2833,6 @@ func (a ThingTypeDTO_Properties) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for TriggerDTO_Configuration. Returns the specified
-// element and whether it was found
-func (a TriggerDTO_Configuration) Get(fieldName string) (value map[string]interface{}, found bool) {
-	if a.AdditionalProperties != nil {
-		value, found = a.AdditionalProperties[fieldName]
-	}
-	return
-}
-
-// Setter for additional properties for TriggerDTO_Configuration
-func (a *TriggerDTO_Configuration) Set(fieldName string, value map[string]interface{}) {
-	if a.AdditionalProperties == nil {
-		a.AdditionalProperties = make(map[string]map[string]interface{})
-	}
-	a.AdditionalProperties[fieldName] = value
-}
-
-// Override default JSON handling for TriggerDTO_Configuration to handle AdditionalProperties
-func (a *TriggerDTO_Configuration) UnmarshalJSON(b []byte) error {
-	object := make(map[string]json.RawMessage)
-	err := json.Unmarshal(b, &object)
-	if err != nil {
-		return err
-	}
-
-	if len(object) != 0 {
-		a.AdditionalProperties = make(map[string]map[string]interface{})
-		for fieldName, fieldBuf := range object {
-			var fieldVal map[string]interface{}
-			err := json.Unmarshal(fieldBuf, &fieldVal)
-			if err != nil {
-				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
-			}
-			a.AdditionalProperties[fieldName] = fieldVal
-		}
-	}
-	return nil
-}
-
-// Override default JSON handling for TriggerDTO_Configuration to handle AdditionalProperties
-func (a TriggerDTO_Configuration) MarshalJSON() ([]byte, error) {
-	var err error
-	object := make(map[string]json.RawMessage)
-
-	for fieldName, field := range a.AdditionalProperties {
-		object[fieldName], err = json.Marshal(field)
-		if err != nil {
-			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
-		}
-	}
-	return json.Marshal(object)
-}
-
 // Getter for additional properties for UIComponent_Config. Returns the specified
 // element and whether it was found
 func (a UIComponent_Config) Get(fieldName string) (value map[string]interface{}, found bool) {
@@ -3608,7 +2972,7 @@ type ClientInterface interface {
 	GetAddonServices(ctx context.Context, params *GetAddonServicesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// InstallAddonFromURL request
-	InstallAddonFromURL(ctx context.Context, url string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	InstallAddonFromURL(ctx context.Context, addonUrl string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetAddonById request
 	GetAddonById(ctx context.Context, addonId string, params *GetAddonByIdParams, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -4041,8 +3405,8 @@ func (c *Client) GetAddonServices(ctx context.Context, params *GetAddonServicesP
 	return c.Client.Do(req)
 }
 
-func (c *Client) InstallAddonFromURL(ctx context.Context, url string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewInstallAddonFromURLRequest(c.Server, url)
+func (c *Client) InstallAddonFromURL(ctx context.Context, addonUrl string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewInstallAddonFromURLRequest(c.Server, addonUrl)
 	if err != nil {
 		return nil, err
 	}
@@ -5819,12 +5183,12 @@ func NewGetAddonServicesRequest(server string, params *GetAddonServicesParams) (
 }
 
 // NewInstallAddonFromURLRequest generates requests for InstallAddonFromURL
-func NewInstallAddonFromURLRequest(server string, url_ string) (*http.Request, error) {
+func NewInstallAddonFromURLRequest(server string, addonUrl string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "url", runtime.ParamLocationPath, url_)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "addonUrl", runtime.ParamLocationPath, addonUrl)
 	if err != nil {
 		return nil, err
 	}
@@ -11440,7 +10804,7 @@ type ClientWithResponsesInterface interface {
 	GetAddonServicesWithResponse(ctx context.Context, params *GetAddonServicesParams, reqEditors ...RequestEditorFn) (*GetAddonServicesResponse, error)
 
 	// InstallAddonFromURL request
-	InstallAddonFromURLWithResponse(ctx context.Context, url string, reqEditors ...RequestEditorFn) (*InstallAddonFromURLResponse, error)
+	InstallAddonFromURLWithResponse(ctx context.Context, addonUrl string, reqEditors ...RequestEditorFn) (*InstallAddonFromURLResponse, error)
 
 	// GetAddonById request
 	GetAddonByIdWithResponse(ctx context.Context, addonId string, params *GetAddonByIdParams, reqEditors ...RequestEditorFn) (*GetAddonByIdResponse, error)
@@ -14518,8 +13882,8 @@ func (c *ClientWithResponses) GetAddonServicesWithResponse(ctx context.Context,
 }
 
 // InstallAddonFromURLWithResponse request returning *InstallAddonFromURLResponse
-func (c *ClientWithResponses) InstallAddonFromURLWithResponse(ctx context.Context, url string, reqEditors ...RequestEditorFn) (*InstallAddonFromURLResponse, error) {
-	rsp, err := c.InstallAddonFromURL(ctx, url, reqEditors...)
+func (c *ClientWithResponses) InstallAddonFromURLWithResponse(ctx context.Context, addonUrl string, reqEditors ...RequestEditorFn) (*InstallAddonFromURLResponse, error) {
+	rsp, err := c.InstallAddonFromURL(ctx, addonUrl, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
@@ -18413,8 +17777,8 @@ type ServerInterface interface {
 	// (GET /addons/types)
 	GetAddonServices(ctx echo.Context, params GetAddonServicesParams) error
 	// Installs the add-on from the given URL.
-	// (POST /addons/url/{url}/install)
-	InstallAddonFromURL(ctx echo.Context, url string) error
+	// (POST /addons/url/{addonUrl}/install)
+	InstallAddonFromURL(ctx echo.Context, addonUrl string) error
 	// Get add-on with given ID.
 	// (GET /addons/{addonId})
 	GetAddonById(ctx echo.Context, addonId string, params GetAddonByIdParams) error
@@ -18893,18 +18257,18 @@ func (w *ServerInterfaceWrapper) GetAddonServices(ctx echo.Context) error {
 // InstallAddonFromURL converts echo context to params.
 func (w *ServerInterfaceWrapper) InstallAddonFromURL(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "url" -------------
-	var url string
+	// ------------- Path parameter "addonUrl" -------------
+	var addonUrl string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "url", runtime.ParamLocationPath, ctx.Param("url"), &url)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "addonUrl", runtime.ParamLocationPath, ctx.Param("addonUrl"), &addonUrl)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter url: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter addonUrl: %s", err))
 	}
 
 	ctx.Set(Oauth2Scopes, []string{"admin"})
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.InstallAddonFromURL(ctx, url)
+	err = w.Handler.InstallAddonFromURL(ctx, addonUrl)
 	return err
 }
 
@@ -22190,7 +21554,7 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/addons", wrapper.GetAddons)
 	router.GET(baseURL+"/addons/services", wrapper.GetAddonTypes)
 	router.GET(baseURL+"/addons/types", wrapper.GetAddonServices)
-	router.POST(baseURL+"/addons/url/:url/install", wrapper.InstallAddonFromURL)
+	router.POST(baseURL+"/addons/url/:addonUrl/install", wrapper.InstallAddonFromURL)
 	router.GET(baseURL+"/addons/:addonId", wrapper.GetAddonById)
 	router.POST(baseURL+"/addons/:addonId/install", wrapper.InstallAddonById)
 	router.POST(baseURL+"/addons/:addonId/uninstall", wrapper.UninstallAddon)
@@ -22315,173 +21679,173 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9e28jt5LvVyF0LzBnFx4rJzm5uDv/eWx5Rli/IMmT3Q2CAdVNSTxukQrJtq1j+Lsv",
-	"+Oon2Q+p9bAz/yQeNZtNVv2qWFUsFl96AV2uKEFE8N6nlx4PFmgJ1Z9ngcCUyL9WjK4QExip3wNKZnge",
-	"M2gf/1+GZr1Pvf/TT7vqm37657nGrye9EPGA4ZV9V6xXqPepxwXDZC6f49D9M1nFeoQwDLF8G0Z3uWGV",
-	"XjE/0Ok/USDkDxGcosjb9H544Xjm6kcT5mJy24A2NaNNOy39cLSEakqlMHShB8ZiQZmz8ykMHuaMxiQ8",
-	"p5GnjSbwRUqb+9HQ15CgwEu9gBKBiJi45yOfx0QwH8XqeBMiAXGEwosNebiEc3SFyYOHw1zAKELZd6eU",
-	"RggqAXtA6yfKQvfA/ZyNcIAIR55nnqEsoYgZFmvnwwLfNxYE4ePRI2J4hlF4VoRUhhqPiHE39b2YtZjI",
-	"j9/DKh9Bnb3HIaZjTB6cqqOzD9CYBWhXn/iMSYjJfEhm1PmJCvFuLLobaj4Cl0110/kCEoIi3wzo/SqE",
-	"At3RCAdubAe6g4l33TjpdimYwTgSEzjXbBRoWanJIWNw3TvpxQT/GaOhbi5YjLZYVgRaepXlAyZhS3XT",
-	"SjlULFWxc7xVPEczTLB3AYfhIySBT7cGUKA5ZesuYbt7GnEBBSosRVU227jYXnbaBfbamVmGYV8YjVc1",
-	"XDPimB9hpVnqAkNxEt3ztGKaUricc/Pply2QGtDlEpKwBSTOy280oU7XSgMyuEQCMYWJFtwurjx3uY48",
-	"vE++1sWHPN84EtF04tIJkqK7o9rcqsdtyJR5rUyWitHcVg9ke2GkRGvZH26vnzY/PN9aQuW1gJNg70+Z",
-	"xQxvSqGk69YWmfTjn4XHIFNm80bG2gxHArFzhgViGDam2WX+NQeR5pJJN25Xpdo/X2IxoRlVWybGEj7j",
-	"ZbzMPCTxcoqYeoaJ/1kcCbyKkKdX8/RKjkA2mVG2hKL3qYeJ+OXnXjJHTASa6x6Jb4K05VKRAMO3WEho",
-	"C4GYm40MwfCWRGv3zBj6M8bMBy0u0GqM/4WcJLPqABFJ0997k8F/TXonveHNZPBlMOqd9C4G58Prs6ve",
-	"Se/z7e3V4Oym98dJeXwxwW58ygdXXjCoyMcaTt0saydxm7hB9UL3DUYxOpzkeTTUjoXPJ3hvXOi8S8ru",
-	"5I4fs9xtL3PJSt2p4FXLlR/dbQJnakrSC4n5NeIczh2h0mX6wO/CeaWQq87PaYgawr4IieHN5e3o+mwy",
-	"vL3pnfR+OxvdDG++9E56g9HoViLkbnBzIX8p48I/45hJZTtG7BF7Yqs1DnfD0GfrGFGVFqmcjtvJ6SZm",
-	"7/rwBeYBfURsPUI8joSThlOGw7k3oDqL4DzL55vBb1Lyv9zcjgYXTinvKLRW4aowtGKIIyIUPc3bbgyI",
-	"BSbzqoCxatDcHRsQhoMFCn/Esg8dy44weUDh0M5kqyntPy5ucSTHYbB05dujMlDZA0xQiIXPvtUcvGm+",
-	"amWnuIny7iZaWj2lxDRtg6FaWLrXDCRgCAXcjkOkagGveHIUGxEMEi5NCxSOvaNtEfGxABvFkds6gEE7",
-	"CzxNMXGwPLCBuFaxnBBXd5k3UHYYJtpjpLBa5Ei1CVo3cclsbQkPyYx2h020XEVQ+M0EhufzNnG8iX7B",
-	"F7TzLJKPmOMpjkxyh7W5vg3Hw89Xg95J7+vw4mIgDezBf90NRpOG1rQVlIm0dVptd1Xbhq03AB3W026h",
-	"Wg3FGWbLJ8gMnupjHdnWZuwVCwENoFdEutxkNpJQh0fJ+4LgVNvGru9dlsJE+Zl4hfvRE5mq/4gTrx19",
-	"RzPUa3TNUUTnG7n9Sxr6XEf5ZITkD4HwRRxWDKloDccCffPmUjXwbh4RCT2JQe1ytMrgL1Es1d/l+I70",
-	"hqQcfmv10S8wihBbS03dKudJPzp7hAKyexZVtPHGQwKGoEDhhTFTkoCI9Oo+CrxEvZPOclFU4qFvnBF+",
-	"0PNtEJNZUS6atmUIhp65Cywi5OdjW6o8YvTUbFQVGPhNrkOCX2Eu3HB49wzzc2VLAksX6DIm/vRqr7JV",
-	"YcVWnpN3AJs5iTMz7LrFrzTHLVw/9Z43kNE63tthkldDrfoVc0HZ+gIK+FmuN05F7tMMy1J8+P/9oyHQ",
-	"vsZLSK4gmcdwjoZEILZi3q2w1iFZaW5FaBcpOcOAkjES5THW7qkpKuWHZE36OxUcH3/74gyhNmB4Bzlw",
-	"xxV7ahldkoO3SHYN3IZaGvklRZFw5SRCAVcUmwM7LaSbChgxFHgOB7imJpnh2BfwZuTHrCnHr+Fq5XMB",
-	"u0rmujZxrop8pe2A0sbEv6ZhHKEjSSvrKiVMT+pI88E6yeXSM/Qm5+4zbrb5Bm8n6/p+A0V3cO45wxK0",
-	"RAGCM7dr642PryBDRNQnSegRGmOExsL9Gb+1/KQ9icaI0Z6HEx1uEuZTp0qk9AOmjWJzJIvs6kvSZecC",
-	"kUBB08aaihIZk6YJMwiyCCMumrtpERSt2rdIrcjMrirPYJfK7o7RGa7QdhvsjfJ4taJM6L032XFLH6f5",
-	"PuaIUuH2IiJrxTSSMm3zuHbalF3v2VGDPGZoiYgYr7mJCpSTomIicdIkRjrKNG0dIZOUuB+e216dBlb6",
-	"yGNJb7dky++1XwGNauMRrc7jbsTHLAUc3CzPoRPnFy8RF3C5aq4i/MZ0c+g7LcvN9hy73nBstK3SyubN",
-	"vd2x6bWJ3bVUJmLzrxtPwPGlHQdmdrSxePhdxR/b7se17f42cXwUG+QSyoNnFMRukz1stanAzLpQl8Xg",
-	"H8o4t6vbLuiY7sJZ4tzfDG+Gk+HZ1fB/Bhcqa9v8SyfoDi8U4Ub3Nzfu/Fzb54Uqr5HLBb29UTQ/u7m4",
-	"Goy+Xw/H4+HNl+825df+nv1g8vD89uZy+OV+pDKGk18ng+u7q7PJoNTX8Obb2dXw4vvoXo32Yjg++3zl",
-	"TEB1EzVnAxbyX2MchWP99vYbpWMJfuhOM1/QJVqZHO2GTq7X8d4gA62FWzR25IwV0s03PPXU9lSEGsku",
-	"jiFxgVaOEXqpsVt/XuJvtTIpOq1Ppes0nR1UT6hCGfemLyTu52c1LpOgsPVGoXby3EIMHyGO4DRCd4wG",
-	"iHPKmu6r6hX6kkYhcmcTzBhC12hpSFi79XXS+yd8hN/8iRfp46rUDt3K/zyiVWOm/IwFCyxQIGLmOZ7E",
-	"vckPlFcmnVABo1YEiTliIRTQO+Bqbnt2KXNoqFQhaUvnlybGtGm/2L6r4O/BswP3lhV44KS9DfLuitl7",
-	"uzcL72/+8+b2Nwmi25urobLzbi8vzV+jwfXtN91O/ek5CbSl3TgafBmOJ4NRY5vSPszblvbkWdHmzP5+",
-	"fX1/MzzP26JuC/XzaHjxZfA9pcXlcHT929lo8P3+7uJsoju8uJ/89/fz/z5X0mjeKNL7iyZCS0t2z/ZB",
-	"kKkG1FqQHTWEXHK9qypCWmHc7aEEQ21C/LNAhONphDJlh4Zhh2dO/LbYngtedJXXvBsLcoI9IavKjD7/",
-	"Ce9HxCK43jIpY0IfEBkhvqKE+wJq0qT9LmRDD8BWmCH+XR96b5T9OWOILyq65AFd+TJaHhD57g+gc23i",
-	"Ve4ScCNLTnqYAOf7StrIhLvea9bGgXe/2oyUI3a2wkry3BzR2cSTYr7jJtvOflnyDa1dFi6j7fIevZ8d",
-	"I869haYijIgYhlXJ8u2oFUEuRloNtXvRr5m4nsCw6Q7eN9rZfr93m9r13TSlpPRhRAIa+iKRC4Tni6Zp",
-	"Fv6UHbNT3uSglk3Lrp67fBJ4i0dHaI5I6M8GQuFdu5joUmcxttj7S9MeHTbLEj4X6+bkQpn+h/7EeMQw",
-	"DT1BSQX5hkzkiISXDP0ZIxKsG78jbT7h4QbXWS6tzxA7g6UnPf6ERbAYa3PNk4rVLm/VxE39eMpHP8rf",
-	"0wleDZWAokigymmPJVq0FFIYi8XPit4RfcocN8L/Umu0rZOS+1GZj72+/K1vH0gFpvSVsYdVWaLemfw/",
-	"5kIv+EDKP9RRcWtemb4Y4kJ3qA217AT0EF9fVQ0+HRIIKBEwEOlq0aMrRL6efe4ZYvcWQqz4p37/6enp",
-	"VD5bwOkpZfN+SAPez+TN2RfBaDCegLO7YS+zD9L7VQfyEYEr3PvU++X0p9O/91QsfqHm2Zf/meuU+WRy",
-	"kiG9L0iMKBU9KQfa4FUv/PzTT3b8xkKAq1WEdSym/0+uNZkW59qtNZuRpEiTs4d6t/+pOR4vl1B6vHI8",
-	"HEjySamSvIBTGgsgFgiYvKET9Y+zuyEw8weQhEAlNwFBAUNcVf7mpz0b9Pu9x+QU/5Bf6sMwNLsdPnqc",
-	"6Rb5YoG/FyI6vcicm+hJbktGIhgi1rM6qHcWBGglPl6lzVJqlYSg2LnRCWB4Ybv/M0ZsnfZuGgzDyn7/",
-	"2JKrzZIF1AUHZZvGyeuT3j9++kcpPNYzmX6AUAFmNCZhTg8o6lsN8LsR2j/k7HK4ATCKAAzDj5TkmG84",
-	"nmV/39CvHgc6V2+PWNgfz9SRqYZ824obQH6hliXCZkVW8mNsGfdDPHfE6t2KqAaEFb86TMQs6r/ELHrt",
-	"m9s+lH1OuQMgQ91ATfeS0eX96KoOI+ojwPQM9AuKm3LdTJkpF+pssT7BYrQBW92UdjyaLBCY40dE5JAA",
-	"5mAJI12kBVCm6P8IIxyetmaAIRFXC6jhxIzRpfp38sU6nryo/w/D11pZ/bxWAnA4OdUMTqU0z1czj1a8",
-	"PVZV0GCBbiXtN11I+RMWC4Or4UVjWLUT9SYYe/Mw2APbXLpB8S/VDW14GJNaLt7bJhqfP3i4NQ8Tim7K",
-	"xTjEtG8qE3KTtObV8LLxhW47lm3fkI1cqS2zt1O1NJEweSjaRzm9KLlgyAskfQGeyX9jopd2+XiGGRcg",
-	"SZ5SzXIMk8Nz8kt5vY05plu/K54lF3615Jp6rznfdPMGnFMNq3nH7ZGsSp6NVat354PmBK25G1riTIS5",
-	"AHSmHE5F0Bqa6/BQPdVNu/dJ91RYuqJ8OeqWp71Y9OEKq7BpNfHNNiDv7YMaxY3HFk7y38u6RPYmPTap",
-	"TOSMERFyfCj0ah/1hk/3XEn62minphyAnNMAyz6BoHqdz34IxByxPBPEwsmD/otE6KseUoT0PkOeGyO0",
-	"pI/IkqcsCQ5LTP1vZ95ydySnLCWql/4j9EgfEICAr1Cg7tjMvLQtIyI6N4fl3fbxhWKK2QI2JEVcfKbh",
-	"ukIMnj8+PT19nFG2/BizSG1e6kSklAONNlbr8lEcGzevrwdjpRltDTs1RRWrzMZ0lonKTob5rqr4Z7qo",
-	"1GWGe/ySsvOYMUSEHPHeFFsmf+A49Zql4dbClMDULUtfkLg9i8WiSo8VnL2Yo3NKH7BzxU7vFvhjZ4Kp",
-	"Ezy+e+QzMBuezgffzZXA7u3aOYNE+NPFGAoxQ4H47r7HqlPV0In1X0rXq8C2QyENiQqnAsNGkMFG2erR",
-	"iX9qxzGnKLgHnFN9T3Clkvhs27w3S7NwSXKjcm9d7j5Z4md5kzAkz5/+i/lrGL7209y3GpblUxpr2FcO",
-	"L+Utp2QAXZhPjRlZ7K+N52yoAEKK9NKAnjFX+VS/ugQtRy0QQL1QTxFgCIYgjJFU/ZgIxAiMAGKMso1Y",
-	"bwgJckmcYEaZiYDZ5/lIWAYYJ71V7ApYqlqrR8b6JktPmesb32iztSJviLef3ZFRQKMwz9edg9NU2O0I",
-	"nxpDHMAWKDWrjYgZ0YHdEhEqFJw5zPGxdnc9cxDisJvr+uI7cD+84GC6BiuGZvgZ/M3e8ARUWcSPJqcO",
-	"haaBpGkU0ScUnigiome4XEXoE/igjyWeLP8U4sO/eSL7to/Dr5mFa7D3uWZynbKRBDANdMrZG3lMOXDW",
-	"f8lfpvTaEHif1/dKRVaCr3BPk1OhltpssS30VoLgReSUYXGeYah2d1eMPuIQhSBPsILSPK3YqwJ27BsB",
-	"LgsxKez3+TW5PdD6ESYPEr25Oms+6F0VG39enxeRc0gsdqZxNqi73HJhNmP6lGfpAkoYAcsUoAYEqIkl",
-	"AFOixr7jx9kXtSTmupYwTeILp5vBTy6mckhaxWU2SQsDS78oDYMpAjpLHghai1a1TH8MC6WNvLqwXAjp",
-	"oHluKgEb6BXZtymu2hzBwumporeb7MbSWqm+DrJ8zkHDAQMvQPovMcOvrWDyeX0/Gh4UKjHDvuw5vFed",
-	"1x4jG0WL7kdDwNdEwOfd5FBJF6EMKrVGjobNoBXaq0abBJ9+w2KR3E1qT48c2/KzqZxanwiIBRTAHGUG",
-	"CX2y5EyJViCiO1TEA1gRdh7LpzVSmfX99xgVEuhZ9FcRxAWe1d9Y0gVnxgIyyRu+JsGCUUJjnrJDWqUq",
-	"yir9uNRRLnrBpto0wARwFFASckBnOlUj6SlhRxWL0SMylwj4hGSgW9RwUtAVDrhnmUwe7jBvWH5CWlZo",
-	"uRJraWWp40eYcICN1goWkMHAF9uOIqBpkaWWoU6WVH11LM1PsSHB4gY9qSJdExg8eHe8atIMZD/YhEsI",
-	"etKmmvo2EEz1K2dIUGBicPVD7r+kL5gMZk9yoorTSI10hbm4pExNxkRvGm2EZz/UXVjv3/v/vkMdqzt7",
-	"3TKB8Z48EPpEQI4Cec6eq7vceC6JRPsGEIzHg8yr4AlHEWAoQPgRGebHJoiWN79zXF/A6QoSFPXn+raq",
-	"/ov54wYu0Ws/U3LeJ/O5a64kCOrE33wASP4DdDo/BR8CulzGBIv1B7dyz4zpMH5h5RVN7nu+tjtFYrFh",
-	"Zu46/JfFhOaTba0hkuW5ZXMrrvdfcPXhhfzM1ayPh/clyxvr1AVMtP9qCOv8Jg6PxhTP3iS4FYTkOpfF",
-	"R1UiJzeZnALiiJuTpdAFsgqM4UDSo1p3mAuq3t+Orr15a8OkQW2NSwoCScIslROyGiqTKX2uIrH1VVA4",
-	"lE31urZDvCa+0QjxOBIVjuOGm9VhMiGgytHlqaPIkSFN/0U1srH16gQ+SZxLRpeKUrVWrOnXrUEyT3eU",
-	"4ueQ+IT2gCnipyIOjNZTNGkfBNTk4QWnwXwkORlnOm/GjT5crRh9RH7T8kw3SGB78FNyO+B46RvSfFc9",
-	"+U/XEPSkauk1OV7jM499Xm3trrMem66o8rqlH2ZDRNk5+0Pbas4gJiqMKCiYImAgFJqX/sMZcLdusZaD",
-	"bGBD4hlz/en2MmHg6RGK6RrAUH1XueBq7CZhj6E55iIfTamUFDwnlFUIymUE54mUnPGhah4es/5qSWo5",
-	"QenxlKgMOdDECVUMZBYzsUDMxkUUWxvSOCZ1VDZLhGolB3RLEpq/I1JbTa+pAWYRnGsFX6a+l7bWQPJa",
-	"fcYGOZwmT/ayqrdsVD8bdCxpVtkvnPN2/S7N1ZSAowgFgvp6tu3a9S6t+iVaThEzwQUmEcPxI4rWng8l",
-	"LXJfMme/ep9mMOLopJwFXE4awEssAI3FKhZWQeqtxRlGUcjB31QaC0jSWHyZKbr54S1/R/2xjY4N5bfN",
-	"Sg69Hos3Be8sDG9ZGpvjQzIyq05v82S4ZtGQ7J3YDSavHtiwFjDYzevB+lS6UrSvcbamQ2vewXVEYQhw",
-	"Eg7ewDgIQ7lg5UN2hfVfOuRJhG6BdAqJtBO8zE60a//F3jn82teCy/sv+o+hfVDv7lyrF6TDkzCtTivr",
-	"wIEOxbjiJvYm5K1s4IwqqvhWfrp79bPkRyX3cgNNXC1qfjHHQOhMrzTq9nUTNFEd/1ru+DrToXkdhVhI",
-	"FbC50wZJCi0zYrOiZ4fURrXoYU7oD9i8Q9gY1SWdMjNQQWuxUtBMDQ9M2nhLZm3aFkbkIEz1crET6VUd",
-	"JlEWp/uYiGyVzf3ZBtEPZ3jv2Y7FszR1DfMcijezazWa6s3aPSK1k6htyWpttdMwrN1OgEB648aQ9Sw2",
-	"7swQRNSgzs0d/4fSEJ0H0nToS4030HMDf1MbYrc3J+D28vIE3N+dgIvb325OwGhwORqMv/5bU8u4eQQu",
-	"+3kSR9FmLJZM0nlYuie5YJAN7Iqsy5LzWA4cKdgnoLbYKcx5Xu6khc4crE6Uyc+uI9Qak7ou+2m9k5Z4",
-	"aI3XZ7X3jAmQnCy8/2vF+51YVDo+1MIPbGps9e2KqatV8BUMmrmA+i2169WNIU+2NuSTCbg/kn18KDuv",
-	"yhYXUElZR/ZfYjFpn41s5K7pLib0L8Di7vWqJZ9HlWVM1Y0XZ6ciPNc60KsC7biAqjqvkxlPO8FvB/BV",
-	"Oi/BbrVB4NRnSUX/Ko9GJRsekdfYhWG4lZ2taoWo3D8620BZpOZXI8q+L8urC1Ne0z5nyDex2n8u81rT",
-	"rkL8h+n3Nrfc7zN2RxPcOEVVNuq/CDhvYHBM4PyobA0B555dWfXg+OyLDuzRJLSkdyo3tismcH5EJsVf",
-	"kZPGs5BsrF9io7oamjqLv0EJTVN5YLpOzn3ee7OVTAvdoH11g+m6HNAvfCGze3BEe7/mfPRV+7qd2x2w",
-	"jIqFPTXbMxDIbh6+pOypVN73RL5q4/Vmbk3E/mbHmz45ePkPle87E/MqV+HYrwOSdpvrAM2awkYBTI+K",
-	"l5FwUqsBjmI/z6FbdsnbzuPoRQ3QytQ/16NJC0wsoQh0eXJFhPZrvmAY2Q0lEuJHHMYwUurCjRHnqn9l",
-	"tMCE/kV0QPfhhGbQkHzQwdptY/y1SKpSTYVtzNawu8qpJrWRXKGY5BK1pGEcofoyTNeq3eGrMMk56ORT",
-	"ZbDIVl0l/SU9qmxidcrvBASU6JJkqpy6uXB4w/TFvRhFKaO6S4fTICkXXMqBp4yn/ssyGUxdtaV02Ac/",
-	"d5AbtCerpdDkOFbDAutbLYD6XSBfBgFleoxhJo3e3MOULcKULXFT3H/OQKamvxpArRDjmAtEqi+RuEub",
-	"HeIqtr2IdnmOu3Z1kozKDBecl6RluVTiW8MU9UvKyjOsY+IwtNUVHEM8BcOZQmpSTyx3Y4apfqlOck8R",
-	"iDkKj//evAyJ9L79jO67cknxaHyyTjximBalD5wcaYeZhultuoC6dZe7BlEDTGxhTU9swpTs9nSXETtV",
-	"3ESVJ0kKkpjNIl2+5BT8vl6v1x+vrz+G4YfJh69fPy2Xnzg/HY/H//OHjwqyU3MB+xZjG5Cw65EhErYe",
-	"154K221W2smE6LJ1wGuOojvQ3FrkzeUESTaLDXhsJuXVsZALkxrxtpeBahE/rIT/JidpZy4o+DsI4RpM",
-	"0YwyBIzEbK0HOpT74ngDfVsF2HicqVZoMco7OEdAX2UvB1oc5GSBeSqWGkhIH5xdwbk5Fuks71s0K+ur",
-	"XDnBFSEyFws5NASDhfwoqvqkbr7th5UtQAky+RAGQ5CEAM4kGXTOlQrooFCKJaahb1RT6UlA9UvdnRY7",
-	"8p2k+vmKuaBs3d55svrWnjjw6t3CdfKyebZtql8rbBGfYnUGEMeCMmRV65C8Z8W6U9tp4tBRUwQkXlC4",
-	"AzW1vUU1SVIMBNXjPPWrbrHf5L7G8tKqdB9lyCdTmJioQ3uXhNEZbhIgvdMNDx8hzdUGrjwXXaoA3f2R",
-	"bmwqWB+B35zy59AF7A2myvHUPNg0AFkcVQNvpBo0ujtKXydQx+hG0fTGnk65eLMmjT1wTon30Lsl4k6N",
-	"glaZBpLWO425ZVCiGH8C6EpfiBKtjaShEEzXQO2DQBL2pfpUbM0CSYPGf8xH57rK2fR2s+uWEKpMGDm2",
-	"puciKtJ0T4zWVC2vqB6loyINeorW9nwDMDPmYh0pgOHlSu2yeTfz5Htq80cv/WrsmAOGZtKaOQXXWNU9",
-	"AXYiqRvgL5JT26kcZXo9NodLXeMdRgzBcK0PLPD2mQuachxA9UUXXBJ9o/gYxhHqc7yMI1hbIH5s2suh",
-	"jzOv1CyEZYfVfA+FmizoGQWxLlteMrJk860NLWltb+eythjxLz9Jd5tnHCSeEOAUXMdc3ewTIa4qQhPw",
-	"9/9fekFvm2Rf22DSMRE4OvySLMEysOTaMkgmzd0lfD4FKWBBaG8vorOUlJgD9BwgFKIN6kwYaCcnhyyr",
-	"5ReU4BR1NPhgJeNDrhqmvs0BL/Prf1kOX+T/6rLFdIjO6LZKeTO9uT2l9OH+PAGl6jbZb9yyMAOr/657",
-	"Pa2ywprsXO+LA52mV1Ws5nW8JdtdNpCsj5vwquLMxeGE5WhtreMT3ORSuu0F16FR+zrFp9a3OgsamTJH",
-	"J9jNLt0PRPuLmA8Eh7xCMNxryuwkj6uW3+dpy3fJ8mR+b5HrKRtbML7m0lzD9ObXph4d37c55HgQHmZu",
-	"FlW7R3yjBfwImLbva26LjDXofgur+XgjELhEWu+t+kvHDtTz4zPxNj7xaib8Fm24PNf1REK1MRU35jeL",
-	"CaFPFaWCYyKnckOffvi/DhboEIu0o4MkWGE50pQFJvO91nia2Hbv0nQys3uLhpNlYFOGm9T9cyjQnLL1",
-	"a+3lNHJKOon8IGEQTxa/HX9VGn+mzVbX3Rz93TaaPRsGclqhFVCW/rKAjyg9EaD2NXgcLIClu8oZUlcR",
-	"bIPwD9x+oWKkpU9uIQwNPQtNdG2q/pCKA0nFfh2kPaNfd/2B523qLrDdf1FwfW2D8TsL8B9g3xDspX4V",
-	"Gd1d20fHXkrpjQpRdju/cYBi/EMo3qRQ7KJQVXwMXvt+RGncVJR0tMe3PtnThZXZJu/1hGcS0ZxGezni",
-	"qRITg8xHnYc7E5bkOdR/SdK2X5tw6+AHu21Cuk+/bnaib5cemw8Prdbere77d6JDFWAwmUgJTVsiJuM+",
-	"Vee1mHk386COk8UNLaef3dwDNArzulQ2/tVTfCSjcQNIlMqfIqBJHIIwVucBMBGIERgBxBhlG54JVGXm",
-	"Db3zqt4Fj9I9+aVZeQB0UqdY/gLIaMdshmBXnJYaoDmPvRys2MhrxcS3ujzse5vw9c0rLF2hvSsYJ5lE",
-	"+1BYVSue9CWamLbnuukPc+mN2NHpjV9xJPBKb3BLDroBp0tYZS5fhIGQvtddtRkl5w5X1fixbfZBZvOx",
-	"7gpN2RnmiGBnlCdCHz3qscRT+ZFpRT6APvhgBjuQr431SyuTOFJ/6iT7Qu62jF9/+qW6NQf6zk2GYLBA",
-	"pXJJ6aEMMzGg5gV4posW1HjJvlezY5clSK0rne0X+GI6+Y9vF9+x5KioRGua9FrGjeYoMwHH8Xi8UZWf",
-	"FvddznVadITJAwpt8CbPcJ8vl8NhVQ2uPJwaCdSL+Sspt1MDnCO438wFkgIk0zm1xeOmVxS73pMKNoBR",
-	"NIXBg/uqs17mcX1QlQRRHCKwwGGICHjC4RwpGXaewdWNv6q2h6uwkF0sGi4Olr3TdXLCvxWI+y9anv1o",
-	"vqNRpKrOUDZOtMk7xXOlHixG0I0a3EaBFxcD9xHfQqM2OwtvUAik8q+J4FVUgCoswmABOZgiRJL6GxUL",
-	"h0GWCvinZVnU5VzJ3oCyYlVBBgmO/M8nye8MzRDjurosoeSjXMeU9aY5UJBjKWE8rZsh7d7E1qkS6DUX",
-	"aInJjFauRKrVkOjyNU5jrkMFlnzsswZKZ+UBcDoBAKc0FtoiUN/L0SgliqaSQMuVOo9YRaRJ0ui9bZfY",
-	"mXXkfSTUzNI8JXGB5P0X+2ddbVs7zINvgGQG7Lm7I9fgOPZAUia32vWwr3VSzVY066wSNwtM5vVlZCay",
-	"2eGLyKTXgUyxnuvQt4SbBsdQG3UsGF6tUJgQcftKL1V1XBRLdRUXta5Kxa3DPCDT2Yktwa7qdcil2oRy",
-	"c2jJoKOEl/6LsBOqVTW2YRNdk+3Vow/yLbawBo9pbanUNjnolHExSXiuTalM5bOUVAXT6bRiaxbYUTtv",
-	"l0u+NV2X9IsbMfXK5bCK5S1X/1Hk23fxKJfOKauO+mI/avCHueV5R+feU3aUaa1Fp02VoW1vX64aTd3V",
-	"omcgxiFYmvovVqWcADwDhCYrcABt6FlaJGqC6iQP5kpH+AsOnZnW+ZJC8pPdlRQi6Ml8RVUADUMOsChe",
-	"veyEbaq6zDpXV+7E3Gwomx7eojYDrlg+t146Z5QFvhC4feYIKM5gxNFJY5VZ1FUn4Alymzdyqnd7Xfdm",
-	"np8P7iaDC+3Y8zUJFowSGptXK4PZei0tXtv1H76GAY2jsJjQMkUBjDkCWHzg3d3TqL6XFGJN4AvGSIAP",
-	"iuYfJLa/f5fM/P5dCuqaxuAJEu26m5Wb6mwM2W0I8HKJQgwFitYe9V25bL9HqO+jdk2VXq5yH0vY3Cyz",
-	"3Fwn5DLealNj1BjeJc8PYAw0zcPepR3QCm/NdaHNlYFqzcXb6sE0WUYRrvminUnprEP1EaR7WVQcAbC7",
-	"rgdRSrnnvQOivTbty5xH18rSwFdteDQ2HY5AWtToqw7hNZKdvq6MUOvEawKOdeN3J0X7SQ/T1LtGnMtZ",
-	"b3egv0tzwYQQyzUyahCUKYsSe6uiHIlNsS+9u2XJlbB37FbCpoe0NNT89VhqsDbDbPkEGWqqry5N+7+k",
-	"xmqFkDylKiHiS7+2zDFsTWPU07UOF020Xbe5jvrAix/ZBDsv9q9viHFMyWsTy9HS511hqPSBR00R9ycK",
-	"ZNtdwSLHI0t+Y0aBFUNpTT6lmTgUmM8wCk93p8Y0HmycxgxpAwhWKq4zG3S3c+aXlDVaP/cRFXxzyRqW",
-	"itttoNRrPb6FCVbYbMl2yQpbfeae/IaAa7hM/jDo2wd9NM30PagHs9lrFsEYZ4f/IunJVzCoTrPWW3WI",
-	"ofB+eJ68PSQ39m3PpS95ppFM662yOd/evu2IUpEh3VZZYixhBrgfgvRjwFT0N9dhmpRKRfAsGmJcsUF7",
-	"FmY5PKH7YPCuapEXSb79WcSWn+tg1/0sDAEkOT63YXOlwPdfkt+b7XNmpnfJ6HJPwu/oKzvuvdbITOa/",
-	"xWUCmpbZa2s35a5/my7Dqb0p6T3waZ+C2hYBeTXdBXMr3M93wd+/isrfoS4xzmcHcDMrhcA1V/zdDydY",
-	"X/K3h5x63PqKuxpjSU3PPfcYh5XzthDfXb263PjPQEw0z7Q7CnCYG7ccrh75I8UB6ptcG/WPqnlc6Hbf",
-	"KDYaYEeyoT6wSYGg5JIyNRWV+eNPh8/e8avaZ2mkaZElkirdsGJGP/qJpAY/zDZ+b8dVvsZLSOxwMjPd",
-	"RtwkMyJ1amumRC9LbRdffD5IMpwJehaf1waxmUEeTcLoxuKuyjHYWiJyUtuV5nM8SnrW+9roWU5Z7XAH",
-	"Qaw34X0CuJDYAJaIWTb6pXGMSKhPpzwnqZZWMP39NZfW2prfRZH9vNYK+3DRqyzdjqT28L5Ugwdbmc6q",
-	"rGddgYLMo1qsNNIg7xIEPzRSW40Ezdm4TbURh2t/WReJswkdrxAKFnV403aN9xi6etz2/DmMQ0yl0Dz4",
-	"O5ZPm9QT2Rm0+ArBh81hleeu7IsnTFWfUGcK7C+aymLBaDxfZI5GppSqZrf6b72RuB9HKDWmOzLN9Oyc",
-	"FFAeKHu02I1Z1PvU6zPERe/1j9f/DQAA//8uV96GBWQBAA==",
+	"H4sIAAAAAAAC/+x9+W8bOZrov0LoPSCzC8Xq6Zl+eJvfHFtOhPUFSU7vbiMIqCpK4qREakiWHY3h/33B",
+	"o26SVSWVDrvzS7cjsnh8F7+LH597AV2tKUFE8N6H5x4PlmgF1Z/ngcCUyL/WjK4RExip3wNK5ngRM5g0",
+	"/1+G5r0Pvf8zyIYamHEGF4XOL/1eiHjA8Dr5VmzWqPehxwXDZCHbcWj/maxjvUIYhlh+DaP7wrIqn5gf",
+	"6OwfKBDyhwjOUOTs+jC6tLTZxtGAuZzeNYBNzWqzQYs/9Hs/3i/oe/PjCq7/0Av6iolAbA4D9Pxy0tBs",
+	"CsowtJEYjMWSMuvgMxh8XzAak/CCRo4+GguXGWwexiNXR4ICJ/QCSgQiYmrfj2yPiWAuiNXhJkQC4giF",
+	"l1vicAUX6BqT7/ZWwgWMIpT/dkZphKDiwu9o80RZaF+4G7MRDhDhyNHmWMoKiphhsbE2lvC+Hbe4aa7f",
+	"e0QMzzEKz8sklYPGI2LcDn0nzSY0UVw/DlsB1Dp6HGI6weS7Vb50NgGNWYD2NcVHTEJMFiMyp9YpPOzd",
+	"mHW3lHwErprKposlJARFrh3Qh3UIBbqnEQ7stB3oAabOw6V/hPNiDuNITOFC41qglVfcQ8bgptfvxQT/",
+	"M0Yj3V2wGO1w9gi0ckrU75iELWVSKwniOc9i63p9hIHmmGCnKgDDR0gClwAOoEALyjZd0vb+YcQFFKh0",
+	"Xvm0v0m5vxy0C9prp7AZhH1iNF7XYM3wbHGFXgXXRgzlTXSPU882JXNZ9+YSQjtQakBXK0jCFiRxUf2i",
+	"CXS6FhqQwRUSiCmaaIHt8vF0XxjIgft0ti4mcsxxIqxppUsrkZQNJ9XnTjW3AVPusypYPKu58y9kd2ak",
+	"REvZnwa0GzY/begObOiKqLBC9e1JvJjhbSGUDt1abaNEoB/CobUp3XorjW6OI4HYBcMCMQwbw+yq+JkF",
+	"SNJVsr61Gz1+S3+FxZTm5HEVGCv4A6/iVa6RxKsZYqoNE3dbHAm8jpBjVNN6LVcgu8wpW0HR+9DDRPzt",
+	"1166R8nGC8Q8Vl2/R1ueJylhuE4USdpCIGZHI0MwvCPRxr4zhv4ZY+YiLS7QeoL/hawgS8QBIhKmf/Sm",
+	"w/+a9vq90e10+Gk47vV7l8OL0c35da/f+3h3dz08v+197VfXFxNsp0/ZcO0kBuVD2cCZHWXtOG4bW6me",
+	"6b7AKEbH4zyHhNoz87kY75UznfNI2R/f8VPmu915Lj2pO2U8P1+5qbuNC05tSZoqMb9BnMOFxem6yhrc",
+	"dp6TC7ka/IKGqCHZl0lidHt1N745n47ubnv93u/n49vR7adevzccj+8khdwPby/lL1W6cO84ZlLYThB7",
+	"xA4vbY1V3tCJ2tqR5JMi3u3YLaFuvP+2iS8xD+gjYpsx4nEkrDCcMRwunK7ZeQQXeTzfDn+XnP/p9m48",
+	"vLRyeUf+N0+Ug6E1QxwRoeBpvrbTgFhisvC5nlWH5jbbkDAcLFH40yv+KrziMhqHwlGyk522dHgPe0Js",
+	"ch2G4K5dITFDT6dCSyjEwqUpazTfNj//8nDY5hjoxjnr39I8JkGTsZUScmU6Gx0vVZDbEGnynZNd/Fxh",
+	"bVghAUMo4G7imfiUDE/LSURUGCRcqj8onDhX28IrlZDuOI7sGgwM2lkJ54HHOAgSj2Irf1OI/UMWlag9",
+	"urJOzeXp53ji16XroCMpQqv0MkGgOwJGq3UEhVvfYXixaOOQnOoPXN5Hx0H+iDme4cjkuyTK45fRZPTx",
+	"etjr9z6PLi+H0lIY/tf9cDxtaBYk3DSVSlur4J5fyW0d7rSogSdAzzUnFGarJ8gM0dV7dvK9zQY9RwoN",
+	"oJOPuoy7G3apI1pJICXu8lsCtvmuKk6x4k6cEuDR4Yern8RK1B3NoxHq1B4XKKKLrZwcKxqiyN0yRvKH",
+	"QLj8K2uGlG+KY4G+OHPQGthyj4iEjoSqdrltVeKvQCwT8pW5Ymn7ST780mrSTzJRkW2kOG+VK6abzh+h",
+	"gOyBRZ4+Tu9PwBAUKLw0Ck/q/pE27HuBV6jX7yw9RyVsutYZ4e+IF5bg9kCtKRdN+0rPqGPvAosIufHY",
+	"FiqPGD01W5WHBn6Xh5Xg15gLOzm8eYS5sbIjgMvmV3Nhq5yorSw05wK2M2Rfk4nphGJneW8NpepnzAVl",
+	"m0so4EcEiV2QuyTDquIN/39/b0hon+MVJNeQLGK4QCMiEFszZ+CvtQNaqlsR2keW0iigZIJEdY21EUQF",
+	"peKSEr3/XoUCJl8+WR3GDRDeQVrgK3SitXSTyR0m5G7bXeLZaWThlPnGlssJBVxTbK5MtRABVMCIocBx",
+	"88K2NYkxS6jEed0hZk3J4gau1y5jsqskuBvjVvPkeR2AmtoYCzc0jCN0Ijl7XeXb6U3tNdluX5tstUNn",
+	"5vMhfXnbB8Y70RAO65e6hws7xHHQkgoQnNuNZKfPfg0ZIqI+uUSv0Kg1NBb2adx695O2SRpTjLZhrNRh",
+	"B2Ex5awCSjfBtBFsliSbfc2EGMdcIBIo0ky8VmWOjEnTRCMEWYQRF80NPukHbtO/RUpKbne+/Ix9Crt7",
+	"RufYI+22CBfzeL2mTOhIoxy4pbXUPLQ7plTY7ZEoUXUacZlWjCxL0RaCI8oHeczQChEx2XDjX6j0YjGR",
+	"dNLE2zrOdW3ta5OQeBhdJKNatbCsyaGT73Zky/nan4BGtPGI+vPfG+ExDwELNqt76MSMxivEBVytm4sI",
+	"t8bdnPStmuV2cdCug6CNojitdN7C1x2rXtvoXSulIjaf3VgClpn27OLZUxzz+EHMn6kArzAV4HUS+0kE",
+	"7SW9D3+gILbr9WGrGAYzh0ddZoV7KZNCELmdjzML+iXAebgd3Y6mo/Pr0f8ML1VKvPmXzn4eXSrAjR9u",
+	"b+3Jz8mYl6oKSiHR9u5Wwfz89vJ6OP52M5pMRrefviX51Mnv+QnTxou726vRp4exSsdOf50Ob+6vz6fD",
+	"ylij2y/n16PLb+MHtdrL0eT847U1u9cO1IKiWEoujnEUTvTXu8dlJ5L4oT2Hf0lXaA0XtdSRs4Sd1vkW",
+	"qXMtbKeJJdmtuJdtr5S1vXKiVrKPO15coLVlhU5o7Nfol/S3Xpu0odZ1AXTq0B7qV/iojDuzJVIb9aNa",
+	"l8mH2DkuqS1BOxPDR4gjmdBwz2iAOKesaRhXH+NXNAqRPXlhzhC6QSsDwtpIW7/3D/gIv7jzPLJmXyaJ",
+	"7uVuj6hvzZSfs2CJBQpEzBx3v7gz14Jy38wqNtIKIDFHTAYXnAv2Y9sRFC1Qg1eEZD2tM02NatP+sH1T",
+	"HuKjZyyeVqbikRMJt8gFLGcU7l93fLj9z9u73yWl3d1ej5QyeHd1Zf4aD2/uvuh+6k/HXawdlcvx8NNo",
+	"Mh2OGyueSWNRAU3u/vV77t9vbh5uRxdFhdWuxn4cjy4/Db9lsLgajW9+Px8Pvz3cX55P9YCXD9P//nbx",
+	"3xfXw+yLMrw/3d1uoe4eWIkIckWbWnO7pdSTjfn3VexJS5X7AxTBqGM+9EMgwvEsQrnqUKOwpXt/O4Xt",
+	"wCVHusq13o+aOcUO55c3y9B9x/4RsQhudswBmdLviIwRX1PCXa45qfd+E7Kjg8DWmCH+DZOy2ubMSJ0z",
+	"xJeeIXlA164Emu+IfHO74jlidcT1wA0vWeFhXKVvK/0j5xN7rcWWOombHjnY1malHLHzNVbsaUebToOe",
+	"4lULF6L73lZAWy2tXfowo+0SNp3TThDnzqJhEUZEjEJfln87aEWQi7GWVe0+dIsvrjcwahow/EI7Sy9w",
+	"RsVt82YZLJWJEQlo6PJpLhFeLJtmdbgzhExgvsk1tCSf3L932RI4q4VHaIFI6E4+QuF9O+/qSmdWtgg1",
+	"ZqmYFsVmBX+UyxsVnKLuRndGP2KY2knIHM8NkcgRCa/kHSZEgk3jb6RiKBzY4DqppvU1aqvbtd/jT1gE",
+	"y4nW6ew4bplLazywbnoq+lGq8+l8soZCQEEkUPXTJ5JaNBdSea3pVwXviD7l7knhf6mDPClnU/hR6Zi9",
+	"gfxtkDSgnpFXRmlW1aN65/L/mAutFQDJ/+ovnupgZiyGuNADql8LG9BLfJE/YeM3CCgRMBDZadGja0Q+",
+	"n3/sGWD3lkKs+YfB4Onp6Uy2LeHsjLLFIKQBH+TS9JIPwXg4mYLz+1EvF1Hp/aZDAojANe596P3t7Jez",
+	"v/aUV3+p9jmQ/1noXP90cxIhvU9IyLScnuQDrRWrD3795Zdk/UZDgOt1hLXDZvAPriWZZufaIF2SAPXy",
+	"UtaHenf/qTEer1aQbfR6OMBEc5XEBZzRWACxRMCkKfXVP87vR8DsH0ASApVLBQQFDHFV6p2f9RL34R89",
+	"Jrf4Vc40gLKYPffB41z3KNZ0/KPk9ulF5sJHT2JbIhLBELFeIoN650GA1uL9ddYtg1aFCcqDG5kARpfJ",
+	"8P+MEdtko5sOo9A77tcdsdosN0GCy6LTWHHd7/39l79XfGg9k1gICBVgLp+6KMgBBf1EAvxhmPbry9cS",
+	"3QAYRQCG4XtKCsg3GM+jf2DgV08HOjXwgLRwOJzJrTXF207YAHKGWpSIzboBPiYJ4n6y555QvV8W1QSR",
+	"sF8dTcQsGjyrvx9Y9DIwb7woJZ1yC5WMdAe15ytGVw/j6zpCUaMDMzLQHyiUysMzw2iyiF6+uqJgMdoC",
+	"wXaYW5qmSwQW+BERuS6AOVjBSFesAZQpTDzCCIdnrVFh4MTVUWpwMmd0BUR+xjrsaMyMwpdarv24GYV1",
+	"iNgrx2osjy49yB2FrXB7qkKhwVHdiu9vu+D3JyyWhq5Gl43Jqh2/N6GxV08GB0CbTTYo/GWyoQ0OY1KL",
+	"xQeCc3j8icPdcZhCdFssyiezBqZKJDeJcE4JLztf6r7yHa/XpC17pWX+YbKWyhIm38uaUkEuSiwY8AIJ",
+	"X4Dn8t+Y6KNdNs8x4wKkCVmqWwFhcnlWfCn7tzHGdO83hbP0rbeWWFPfNceb7t4Ac6qjH3c8uQvmxdlE",
+	"9Xpz1miB0ZobpBXMRJgLQOfK9FQArYG5wksDqJt+bxPuGbN0Bfmq/60Ie+mOXWPlQPUD3wQEee8Q0CiH",
+	"IFuYy3+tyhI5mrTYpDCRO0ZEyPWh0Cl91Bcu2SPrQ6V+Tw05ADmnAZZjSr+nbCxMBGKOWBEJYmnFweBZ",
+	"UuiLXlKEBKpiY4xW9BEl4KlygkUTU//bm7XcHcgpy4DqhP8YPdLvCEDA1yhQz6vmPtoVERFdmFv6dv34",
+	"UiHFBIMNSBEXH2m48bDBj/dPT0/vpbvgfcwiFcbUeUsZBhqFWOvSVywhnJeXo6HSrLYGnRqiClUmRJ1H",
+	"otKTYXEoH/7MEF5ZZrDHryi7iBlDRMgVH0yw5TIJTlOuJTDcmZlSMrXz0ick7uTLyD45VjL2Yo4uKP2O",
+	"rSd29hjE170xpk71+Obgz8CEPq0N38xr0PbA7YJBItzZZQyFmKFAfLM/PNapaOhE+69k93lo2yKQRkS5",
+	"U4FBI8jRRlXr0XmCKvZYEBTcQZwz/US0V0h8TPq8NU2z9D52o4p1XcahEuDncZP8VsLP4Nn8JZ1XWRZc",
+	"DcqKGZA16Ku6l4qaU7qALtSnxogsj9fGcjZQACFF+mhAPzBXmVW/2RitAC0QQH1QzxBgCIYgjJEU/SqP",
+	"ksAIIMYo2wr1BpCgkPMJ5pQZD1jSXvSE5Qij31vHNoelKhd7YqhvcvRUsb71E0Q7C/KG9Par3TMKaBQW",
+	"8bp34jRFgjuiT01DHMAWVGpOGxEzoh27FSB4BJy5+/G+Ns6euzdx3DC7fqkQyMsIYLYBa4bm+Af4S/Ik",
+	"F1BFG9+b7DoUmg4SplFEn1DYV0BEP+BqHaEP4J2+6thf/VOId//m8OwnYxz/zCw9bn7IM5Pr5I3UgWlI",
+	"p5rHUaQpC50NnouvX700JLyPmwclIr3EVxzaLlArfXYIC70WJ3iZcqpkcZFDqDZ314w+4hCFoAiwktA8",
+	"88SqQLL2rQguT2KS2R+KZ3J7QhvIjEBJvYUCby7Suy53/ri5KFPOMWmxM4mzRenolgezWdOHIkqXUJIR",
+	"SJAC1IIANb4EYMreJN+46eyTOhILQ0syTf0LZ9uRn1jqJakReS5IWlpYNqNUDGYI6Hx5IGgttapj+n1Y",
+	"qqnklIXVCkxHzXiTXRHQJ7IrKK76nMDB6Sjft588x8pZqWYHeTwXSMNCBk4CGTzHDL+0IpOPG/n06TFJ",
+	"JWbYLv50w+FkXnsa2cpb9DAeAb4hAv7YTw6VNBGqRKXOyPGoGWmFyduwTZxPv2OxTB+TTe6RnNrxsy2f",
+	"JjYREEsogLn5DFL45MGZ/lgGot1VxAPocTtPZGsNV+Zt/wN6heSD14N1BHEJZ/WPrnSBmYmATOKGb0iw",
+	"ZJTQmGfokFqp8rJKOy4zlMtWsClzDbBMLJbVELkMRcuWbKQUHT4Uo0dknjhwMclQ96jBpKBrHHDHMZk2",
+	"7jFvWE4hNSu0WouN1LIkviEm8mqNllrBEjIYuHzbUQQ0LPLQ0r8UQDVQF9TcEBsRLG7Rkyr8NYXBd2fE",
+	"qybNQI6DjbuEoCetqqm5gWBqXLlDggLjg6tf8uA5+8BkMDuSE5WfRkokGau6okxtRv/KGwXC8xN159b7",
+	"98G/71HG6sFedkxgfCDfCX0ioACBImYv1HN0vJBEom0DCCaTYe5T8ISjCDAUIPyIDPJj40Qrqt8FrC/h",
+	"bA0JigYL/eDW4Nn8Ict2vQxyte5dPF94qUsSQR37mwmAxD9AZ4sz8E66qmKCxeadXbjn1nQcu9D7ypT9",
+	"qbLd7pMktGF2brsGmKcJjaektyaRPM4TNLfC+uAZ+y8vFHeudn06uK9o3linLmCi7VcDWOucODwZVTz/",
+	"GOJOJCTPuTx9+BI5ucnkFBBH3NwxhTYi89AYDiQ8/LLDvLH19iK6ZmPbJg1qbVxCEEgQ5qGcgtVAmczo",
+	"Dx+IE1sFhSPZVZ9re6TXZL7NGPE4Eh7DcctgdZhuCKjqdUXoKHDkQDN4Vp0S37o/gU8CR96LU5Cq1WLN",
+	"uHYJkmvdU4qfheNT2Mtr3jIHO2VxYKSegkl7J6AGDy8ZDWaS9GacGbwZNgZwLZ3qyK1anusOKdke/Zbc",
+	"HjBemUOq72ok9+0agp5U6b0m12tc6rHLqq2NOuu16doqLzvaYYmLKL9nt2tb7RnERLkRBZXeZUNCofno",
+	"P6wO98Qs1nyQd2xIesZcT92eJwx5OphitpHXiuS8slnvziTsMbTAXBS9KV5OwQtCmYdRriK4SLnknI9U",
+	"9/CU5VdLUMsNSounAmXIgQZOqHwg85iJJWKJX0ShtSGMY1IHZXNEqF5yQXckhfkbAnUi6TU0wDyCCy3g",
+	"q9B3wjZRkJxan9FBjifJ01iWP2SjxtliYLioGRcueLtxV+bhTMBRhAJBXSMn/dqNLrX6FZIlkoxzgUmK",
+	"4fgRRRvHRGmPwkzm7lfvwxxGHPWrWcCVqSO8wgLQWKxjkQhIHVqcYxSFHPxFpbGANI3FlZmiux9f87dU",
+	"Itvq2lAxbFYx6NUP7hS88zC8Y5lvjo/I2Jw6ve2T4Zp5Q/LPejfYvGpI3FrA0G5RDtan0lW8fY2zNS1S",
+	"8x5uIgpDgFN38BbKQRjKA6vosiud/9IgTz10S6RTSKSe4ER2Kl0Hz8mLyC8Dzbh88Kz/GCUN9ebOjfpA",
+	"Gjwp0uqkskKSubpk85uYyXfTgXOiyDNXcbsHtbPkpBJ7hYWmphY1v5hrIHSuTxr1gLxxmqiBf6sOfJMb",
+	"0HyOQiykCNjeaIMkIy2zYnOi55fURrToZU7pT7J5g2RjRJc0ysxCBa2llZJkanhhMvG35M6mXcmIHAWp",
+	"Tix2wr1qwNTLYjUfU5b16dwfEyf68RTvA+uxeJ6lrmFeoOLt9FpNTfVq7QEptROvbUVrbRVpGNWGEyCQ",
+	"1rhRZB2HjT0zBBG1qAtpBZDwaBKic0eadn2p9QZ6b+AvKiB2d9sHd1dXffBw3weXd7/f9sF4eDUeTj7/",
+	"W1PNuLkHLj89iaNoOxRLJOk8LD2SoInY2t5kKVgsR/YUHJKgdogUFiwve9JCZwZWJ8LkV9sVak2TukL7",
+	"Wb2Rllpojc9nFXvGBEhMlr7/zfN9JxqVwkEbO7CpsjVITkxdrYKvYdDMBNRfqahXN4o82VmRTzdgnyTf",
+	"fCw9z6eLC6i4rCP9L9WYtM1GtjLX9BBT+idAcfdyNQGfQ5TlVNWtD2erILzQMtApApN1AVV/XicznnVC",
+	"vx2Qr5J5Ke36FQKrPEtr+/ssGpVseEJWYxeK4U56tjw/dO4fnftA7r253Riyb0vz6kKV17AvKPJNtPZf",
+	"q7jWsPOw/yibb3vN/SGndzShGyuryk6DZwEXDRSOKVyclK4h4MI+vG44Pf2iA300dS3pSOXWesUULk5I",
+	"pfgzYtJYFhKN9UdsVFdDU2fxNyihaSoPzDbpvc8HZ7aS6aE7tK9uMNtUHfqlGXLRgxOK/Zr70dft63bu",
+	"dsEyKhf2VD/kSSAfPHzO0OMV3g9Efpr4683emrD97Z6DPgXycl8qP3Qm5nWhwrFbBqT9tpcBGjWlQAHM",
+	"ropXKaFfKwFOIp5nkS37xG3nfvSyBGil6l/o1WQFJlZQBLo8uQJC+zNfMIySgBIJ8SMOYxgpcWGnEeup",
+	"f22kwJT+SWRA9+6EZqQh8aCdtbv6+GspySeaSmHM1mR3XRBNKpDsEUzyiFrRMI5QfRmmG9Xv+FWY5B50",
+	"8qlSWGSvrpL+0hFVNrG65deXN/50STJVTt28T7xl+uJBlKIMUd2lw2kiqRZcKhBPlZ4Gz6t0MXXVlrJl",
+	"H/3eQWHRjqyWUpfTOA1LqG91AOpvgfwYBJTpNYa5NHrzDlO+CFO+xE05/pwjmZrxaghqjRjHXCDif0Ti",
+	"Put2jEfZDsLa1T3u29RJMypzWLA+l5Zrr+KtYYr6FWXVHdYhcRQm1RUsSzwDo7mi1LSeWOHFDN1J3+SW",
+	"FRU5Ck//Bb0ciHTcfk4PXbmkfDU+PSceMcyK0gdWjLSjmYbpbbqAemIud01EDWhiB216miRMyWHP9umx",
+	"U8VNVHmSZLdJsEiXLzkDf2w2m837m5v3Yfhu+u7z5w+r1QfOzyaTyf98dUFBDirwrmsbkrDrlSEStl7X",
+	"gQrbbVfaybjocpRcdxXdQs2tWd48TpBmsyQOj+243O8LuTSpEa/7GPCz+HE5/He5yWTngoK/ghBuwAzN",
+	"5e0zwzE7y4EO+b683kC/VgG2XmcmFVqs8h4uZNRPZbrSeWWRU3mtNSVPTUhIX5xdw4W5FmlbzLqsVtZX",
+	"ubISV4TIQizl0hAMlnJS5JtSd991YqULUIJMPoShIZmPCOcSDDrnSjl0ZDVkxDANXauaSUsCsk1hTfY3",
+	"LfZkO0nx8xlzQdmmvfGUyNvkxoFT7pYelpfd830z+erRRVyC1epAnAjKUCJaR+QtC9a96k5Ti4yayRg+",
+	"ZSjcg5jaXaOapikGgup1nrlFtzhscl9jfmlVuo8y5OIpTIzXob1JwugcN3GQ3uuOx/eQFmoDe+9FVypA",
+	"d3+lG5sK1idgN2f4OXYBe0NTVX9qkdg0AbI48hPeOI5sFOd+TqAO0Y286Y0tnQrlGNAkF84pcV56Nz33",
+	"qxS0yjSQsN6rzy1HJQrxfUDX+kGUaGM4DYUyPqHiIJCEAyk+FVrzhKS+9Vzz0bmucje9/UTdUkBVASPX",
+	"1vRehCdNt2+kpup5TfUqq91v0VO0Se43ALNjLjaRIjAs3+LQi7QH8+R3Kvijj361dszlG1tSmzkDN1jV",
+	"PQHJRjIzwF0kp3ZQucrseWwu709IvzuMGILhRl9Y4O0zFzTkpP9Ozmgjl1TeKDxKV/yA41UcwdoC8RPT",
+	"Xy59kvuk5iCsGqxmPhRqsKAfKIh12fKKkiW776xoSW17N5O1xYr/9os0t3nOQOIpAM7ATczVyz4R4qoi",
+	"NAF//f+VD3TYJP/ZFpuOicDR8Y9kSSzDBFw7OsmkuruCP85ARrAgTF4vovMMlJgD9CNAKERb1JkwpJ3e",
+	"HEpQLWdQjFOW0eBdwhnvCtUwFRYl/rifD5/l/+qyxbSLzsg2L7+Z0eyWUtZ4OEtAibpt4o07FmZg9fPa",
+	"z1OfFtYkcn0oDHSaXuU5zetwS3Z7bCA9H7fBlefOxfGY5WR1rdNj3PRRut0Z1yJRBzrFp9a2Og8aqTIn",
+	"x9jNHt0PRPuHmI9EDkWBYLDXFNlpHlctvi+ynm8S5en+XiPWMzS2QHzNo7kG6c2fTT05vO9yyfEoOMy9",
+	"LKqiR3yrA/wEkHboZ27LiDXU/RpO88lWRGBjaR1bdZeOHar201Pxtr7xajb8GnW4Itb1RkIVmIob45vF",
+	"hNAnT6ngmMit3NKnn/avBQXaxYJ4ojLl/Y5NUWAy32uVp2nS702qTmZ3r1FxShDYFOEmdf8CCrSgbPNS",
+	"+ziN3JJOIj+KG8SRxZ+s35fGn+uz03M3J/+2jUbPlo6cVtQqg/rpL0v4iLIbASquweNgCRK4q5yh0eWO",
+	"FP6OJzN4VlqZcgdmaGhZaKBrVfUnVxyJKw5rIB2Y+vXQ73hRp+6CtgfPilxf2tD4fULgP4l9S2KvjKvA",
+	"aB86aTr1UkqvlIny4fzGDorJT6Z4lUyxj0JV8SlY7YdhpUlTVtLeHtf5lNwu9GabvNUbnqlHcxYd5Iqn",
+	"SkwMcpNaL3cmv5UwNHhO07ZfmmDr6Be7zXKBS75ud6Nvnxabix5anb07vfdvpQ5VgMFkIqUwbUkxOfPJ",
+	"n9di9t3MgjpNFDfUnH61Yw/QKCzKUtn5N0fxkawXCCBRIn8m72RIUIYgjNV9AEwEYgRGADFG2ZZ3AlWZ",
+	"eQPvoqi3kUflnfzKrhwE1K8TLH8CymiHbIZgV5iWEqA5jp0Y9ATyWiHxtR4Phw4Tvrx6gaUrtHdFxmkm",
+	"0SEElu/Ek7ZEE9X2Qnf9qS69Ej06e/ErjgRe6wC3xKCd4HQJq9zjizCQN0/BvV+NknuHaz/9JH0OAWYz",
+	"WXeFppIdFoBgfisBYYAe9VrimZxk5skH0BcfzGKH8rNJPMtW1uTWSf6DwmsZv/3yN39vDvSbmwxBmUpb",
+	"LpeUXcowGwNqX4DnhmgBjef8dzURuzxAak3p/LjA5dMpTr6bfycBh6cSrenSa+k3WqDcBizX4/FWVX5a",
+	"vHe50GnRsgwfChPnTRHhLluuQIe+GlxFcmrEUM/mr7TcTg3hnMD7ZjYiKZFktqe29LjtE8W276SADWAU",
+	"zWDw3f7UWS/XXLtvTIIoDhFY4jBEBDzhcIEUD9vmNp0/q77Hq7CQPywaHg4Jemeb9IZ/KyIePGt+dlPz",
+	"PY0iVXWGskkqTd4oPXvlYNmDbsTgDhNUDgP7Fd9SpxYTvEYmkMK/xoPnqQBVOoTBEnIwQ4ik9Tc8B4eh",
+	"LOXwz8qySOhksQGlxaqCDHBR/rmf/s7QHDGuq8sSSt7Lc0xpbxoDJT6WHMazuhlS7011HR9Db7hAK0zm",
+	"1HsSbbipgcdW0K7MdSjA0sk+akLprDwAzjYA4IzGQmsEar4CjDKgaCgJtFqr+4g+IE3TTm8tXJLsrCPr",
+	"I4VmHubpj2WQD56TP+tq2ybLPHoAJLdgu9wvdjiNGEiG5FZRj+SzTqrZimaDeelmicmivozMVHY7fhGZ",
+	"7DmQGdZ7HbmOcNPhFGqjTgTD6zUKUyDuXunFV8dFoVRXcVHnqhTc2s0DcoP1kxLsql4HWKeu3AK15Kij",
+	"Qi+DZ5FsqFbUJB2byJr8qA55UOyxgzZ4SmeLV9oUSKdKF9MU51qVylU+y0BVUp3OPKFZkKza+rpcOtds",
+	"U5EvdoqpFy7HFSyvufqPAt+hi0fZZE5VdNQX+1GLP84rz3u6956howprzTptqgzt+vqybzV1T4uegxiH",
+	"YGXqvyQipS/f0yc0PYEDmLiepUaiNqhu8mCuZIS74NC56V0sKSSn7K6kkHxtWc8iDzkoH0rDovz0spVs",
+	"M9Flzrm6cifmZUPZ9fgatVmw5/jc+eicUxa4XOBJm8WhOIcRR/3GIrMsq/rgCfIkb+RMR3tt72ZeXAzv",
+	"p8NLbdjzDQmWjBIam0+9zmx9lpaf7foPV8eAxlFYTmiZoQDGHAEs3vHu3mlU86WFWFPyBRMkwDsF83eS",
+	"tr99k8j89k0y6obG4AkSbbrrAXSNUKaGDQFerVCIoUDRxiG+vcf2WyT1Q9Su8clln/lYoc3tMsvNc0I2",
+	"5a02NUat4U3i/AjKQNM87H3qAa3orbksTHJloDpz8a5yMEuWUYBrfmjnUjrrqPoE0r0SqjgBwu66HkQl",
+	"5Z73jkjttWlf5j66FpaGfFXAo7HqcALcolbvu4TXiHcGujJCrRGvATjRnd8cFx0mPUxD7wZxLne924X+",
+	"LtUF40Ks1siooaBcWZTYWRXlRHSKQ8ndHUuuhL1T1xK2vaSlSc1dj6WG1uaYrZ4gQ03l1ZXp/6eUWK0o",
+	"pAgpL4m40q8T5Bi0Zj7q2Ua7i6Zar9teRr3j5Um2oZ3n5K8viHFMyUsTzTGBz5uiocoEjxoi9ilKYNtf",
+	"wSJLUwJ+o0aBNUNZTT4lmTgUmM8xCs/2J8Y0PSR+GrOkLUjQK7jOE6d7smf5QmWj8/MQXsFXl6yRQHG3",
+	"AEq91OM7qGClYEt+SFYK9Zl38hsSXMNj8qdC397po2Gm30E9ms5ecwjGOL/8ZwlPvoaBP81ah+oQQ+HD",
+	"6CL9ekRuk68dj74UkUZyvXfK5nx9cdsxpSIHup2yxFiKDPAwAtlkwFT0N89hmpRKBfA8NcTYE6A9D/MY",
+	"ntJDIHhftcjLIN/9LmLL6TqIup+HIYCkgOc2aPYy/OA5/b1ZnDO3Pfk06oGY3zJWft0HrZGZ7n+HxwQ0",
+	"LPPP1m6LXXeYLoepEXk7eDoko7algKKY7gK5HvPzTeD3zyLy9yhLjPHZAbmZk0Lgmif+HkZT1eUgOfW4",
+	"9RN3NcqS2p597zEOvftOSHx/9eoK6z8HMdE40+YowGFh3XK5euWPFAdoYHJt1D98+7jU/b6ofnvkDTXB",
+	"NgWC0kfK1FZU5o87HT7/xq/qn4eR+qEAJFW6Yc2MfHQDSS1+lO/81q6rfI5XkCTLye10F3aTyIjUra25",
+	"Yr08tG14cdkg6XKm6If4uDEUm1vkySSMbs3u8sO0lojc1G6l+SxN6cg6ri3fmVNNgAZBzLiPAZeSNkAC",
+	"xDwa3dw4QSTUt1N+pKmWCWO6x2vOrbU1v8ss+3GjBfbxvFd5uJ1I7eFDiQYHbeUG82nPugIFWUS1tNJI",
+	"grxJIvgpkdpKJGjuxm0rjTjcuMu6SDqb0skaoWBZR29ar3FeQ1fNbe+fwzjEVDLNd/fAsrVJPZG9kRZf",
+	"I/h9e7IqYleOxVOkqinUnYLkFw1lsWQ0XixzVyMzSPnRrf5bryQexhDKlOmOVDO9OysE5LeIPSa0G7Oo",
+	"96E3YIiL3svXl/8dAAdV1S4lZgEA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -22555,4 +21919,3 @@ func GetSwagger() (swagger *openapi3.T, err error) {
 	}
 	return
 }
-
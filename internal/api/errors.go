@@ -0,0 +1,77 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"strings"
+)
+
+// maximum number of bytes read of an error body, error pages of proxies can be large
+const maxErrorBodySize = 64 * 1024
+
+// maximum length of a plain text error body used as message
+const maxErrorMessageLength = 500
+
+// errorBody is the JSON body openHAB sends for failed requests, e.g.
+// `{"error": {"message": "Item test_item is not editable.", "http-code": 405}}`.
+type errorBody struct {
+	Error *struct {
+		Message  *string `json:"message,omitempty"`
+		HttpCode *int    `json:"http-code,omitempty"`
+	} `json:"error,omitempty"`
+}
+
+// ResponseError describes a response with an unexpected status, including the message sent by openHAB.
+type ResponseError struct {
+	StatusCode int
+	Status     string
+	// Message is the message of the error body, it is empty if openHAB did not send one
+	Message string
+}
+
+func (e *ResponseError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("status %s", e.Status)
+	}
+
+	return fmt.Sprintf("status %s, %s", e.Status, e.Message)
+}
+
+// ReadResponseError reads and closes the body of the given failed response and returns the error it describes. The
+// message is taken from the JSON error body of openHAB, short plain text bodies are used as they are.
+func ReadResponseError(resp *http.Response) *ResponseError {
+	responseError := &ResponseError{
+		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+	}
+	if responseError.Status == "" {
+		responseError.Status = fmt.Sprintf("%d %s", resp.StatusCode, http.StatusText(resp.StatusCode))
+	}
+
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+	if err != nil || len(body) == 0 {
+		return responseError
+	}
+
+	decoded := &errorBody{}
+	if json.Unmarshal(body, decoded) == nil {
+		if decoded.Error != nil && decoded.Error.Message != nil {
+			responseError.Message = *decoded.Error.Message
+		}
+		return responseError
+	}
+
+	// ignore HTML error pages, e.g. of Jetty or a reverse proxy
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	message := strings.TrimSpace(string(body))
+	if mediaType == "text/plain" && len(message) <= maxErrorMessageLength {
+		responseError.Message = message
+	}
+
+	return responseError
+}
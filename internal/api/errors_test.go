@@ -0,0 +1,50 @@
+package api
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func testErrorResponse(status int, contentType string, body string) *http.Response {
+	return &http.Response{
+		StatusCode: status,
+		Status:     http.StatusText(status),
+		Header:     http.Header{"Content-Type": []string{contentType}},
+		Body:       ioutil.NopCloser(strings.NewReader(body)),
+	}
+}
+
+func TestReadResponseError(t *testing.T) {
+	tests := []struct {
+		resp    *http.Response
+		message string
+	}{
+		{
+			resp: testErrorResponse(http.StatusMethodNotAllowed, "application/json",
+				`{"error": {"message": "Item test_item is not editable.", "http-code": 405}}`),
+			message: "Item test_item is not editable.",
+		},
+		{
+			resp:    testErrorResponse(http.StatusBadRequest, "text/plain", "Invalid item name\n"),
+			message: "Invalid item name",
+		},
+		{
+			resp:    testErrorResponse(http.StatusBadGateway, "text/html", "<html><body>Bad Gateway</body></html>"),
+			message: "",
+		},
+		{
+			resp:    testErrorResponse(http.StatusNotFound, "application/json", ""),
+			message: "",
+		},
+	}
+
+	for _, test := range tests {
+		err := ReadResponseError(test.resp)
+		if err.StatusCode != test.resp.StatusCode || err.Message != test.message {
+			t.Errorf("expected status %d with message %q, got %d with message %q", test.resp.StatusCode,
+				test.message, err.StatusCode, err.Message)
+		}
+	}
+}
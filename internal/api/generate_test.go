@@ -0,0 +1,42 @@
+package api
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/deepmap/oapi-codegen/pkg/codegen"
+	"github.com/deepmap/oapi-codegen/pkg/util"
+)
+
+// the version in the header depends on the module running the generator
+var generatedHeader = regexp.MustCompile(`(?m)^// Code generated by .* DO NOT EDIT\.$`)
+
+// TestGeneratedClientMatchesSpec ensures the client is only changed through the spec, see the go:generate directive in
+// main.go. Changes made to api.go by hand are lost on the next generation.
+func TestGeneratedClientMatchesSpec(t *testing.T) {
+	swagger, err := util.LoadSwagger("apispec-openhab-3.2.0.json")
+	if err != nil {
+		t.Fatalf("unable to load spec: %s", err)
+	}
+
+	generated, err := codegen.Generate(swagger, "api", codegen.Options{
+		GenerateTypes:      true,
+		GenerateClient:     true,
+		GenerateEchoServer: true,
+		EmbedSpec:          true,
+	})
+	if err != nil {
+		t.Fatalf("unable to generate client: %s", err)
+	}
+
+	actual, err := os.ReadFile("api.go")
+	if err != nil {
+		t.Fatalf("unable to read client: %s", err)
+	}
+
+	if generatedHeader.ReplaceAllString(generated, "") != generatedHeader.ReplaceAllString(string(actual), "") {
+		t.Errorf("api.go differs from the client generated from apispec-openhab-3.2.0.json, change the spec and " +
+			"run go generate instead of editing api.go")
+	}
+}
@@ -0,0 +1,191 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// maximum number of bytes of a body that are logged
+const maxLoggedBodySize = 16 * 1024
+
+const redacted = "<redacted>"
+
+// names of headers, query parameters and JSON keys whose values must not be logged, e.g. passwords in thing
+// configurations or session cookies
+var sensitiveNamePattern = regexp.MustCompile(`(?i)authorization|password|passwd|secret|token|api_?key|credential|cookie`)
+
+// environment variables controlling the log level of the provider, the most specific one takes precedence
+var logLevelEnvs = []string{"TF_LOG_PROVIDER_OPENHAB", "TF_LOG_PROVIDER", "TF_LOG"}
+
+// LoggingDoer logs requests and responses using tflog, so the log level is controlled by TF_LOG_PROVIDER. Method,
+// URL, status and latency are logged at debug level, headers and bodies at trace level. Credentials are redacted.
+type LoggingDoer struct {
+	doer HttpRequestDoer
+	// bodies are only buffered and redacted if trace logs are kept
+	trace bool
+}
+
+func NewLoggingDoer(doer HttpRequestDoer) *LoggingDoer {
+	return &LoggingDoer{doer: doer, trace: traceEnabled()}
+}
+
+// traceEnabled returns true if the environment enables trace logs of the provider. The log level is applied by
+// Terraform after the provider logged a message, so the environment is the only hint whether trace logs are dropped.
+func traceEnabled() bool {
+	for _, name := range logLevelEnvs {
+		if level := strings.ToUpper(os.Getenv(name)); level != "" {
+			return level == "TRACE" || level == "JSON"
+		}
+	}
+
+	return false
+}
+
+func (d *LoggingDoer) Do(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	fields := map[string]interface{}{
+		"method": req.Method,
+		"url":    redactUrl(req),
+	}
+
+	if d.trace {
+		tflog.Trace(ctx, "Sending openHAB request", mergeFields(fields, map[string]interface{}{
+			"headers": redactHeaders(req.Header),
+			"body":    requestBody(req),
+		}))
+	}
+
+	start := time.Now()
+	resp, err := d.doer.Do(req)
+	fields["duration_ms"] = time.Since(start).Milliseconds()
+
+	if err != nil {
+		tflog.Debug(ctx, "openHAB request failed", mergeFields(fields, map[string]interface{}{"error": err.Error()}))
+		return resp, err
+	}
+
+	fields["status"] = resp.StatusCode
+	tflog.Debug(ctx, "openHAB request finished", fields)
+
+	if !d.trace {
+		return resp, nil
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return resp, nil
+	}
+
+	tflog.Trace(ctx, "Received openHAB response", mergeFields(fields, map[string]interface{}{
+		"headers": redactHeaders(resp.Header),
+		"body":    redactBody(body),
+	}))
+
+	return resp, nil
+}
+
+// requestBody returns a copy of the body of the given request, the body of the request itself is kept.
+func requestBody(req *http.Request) string {
+	if req.Body == nil || req.GetBody == nil {
+		return ""
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return ""
+	}
+	defer body.Close()
+
+	content, err := ioutil.ReadAll(io.LimitReader(body, maxLoggedBodySize+1))
+	if err != nil {
+		return ""
+	}
+
+	return redactBody(content)
+}
+
+func redactUrl(req *http.Request) string {
+	u := *req.URL
+	u.User = nil
+
+	query := u.Query()
+	for name := range query {
+		if sensitiveNamePattern.MatchString(name) {
+			query.Set(name, redacted)
+		}
+	}
+	u.RawQuery = query.Encode()
+
+	return u.String()
+}
+
+func redactHeaders(headers http.Header) map[string]string {
+	result := make(map[string]string, len(headers))
+	for name := range headers {
+		if sensitiveNamePattern.MatchString(name) {
+			result[name] = redacted
+		} else {
+			result[name] = headers.Get(name)
+		}
+	}
+
+	return result
+}
+
+// redactBody removes the values of sensitive keys from JSON bodies, other bodies are logged as they are. Long bodies
+// are truncated.
+func redactBody(body []byte) string {
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err == nil {
+		if redactedBody, err := json.Marshal(redactValue(value)); err == nil {
+			body = redactedBody
+		}
+	}
+
+	if len(body) > maxLoggedBodySize {
+		return string(body[:maxLoggedBodySize]) + "...(truncated)"
+	}
+
+	return string(body)
+}
+
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, element := range v {
+			if sensitiveNamePattern.MatchString(key) {
+				v[key] = redacted
+			} else {
+				v[key] = redactValue(element)
+			}
+		}
+	case []interface{}:
+		for i, element := range v {
+			v[i] = redactValue(element)
+		}
+	}
+
+	return value
+}
+
+func mergeFields(fields map[string]interface{}, additional map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{}, len(fields)+len(additional))
+	for key, value := range fields {
+		merged[key] = value
+	}
+	for key, value := range additional {
+		merged[key] = value
+	}
+
+	return merged
+}
@@ -0,0 +1,268 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RetryOptions configures the retry behaviour of a RetryDoer.
+type RetryOptions struct {
+	// MaxRetries is the number of retries after the first attempt, 0 disables retries
+	MaxRetries int
+	// Backoff is the wait time before the first retry, it is doubled for every further retry
+	Backoff time.Duration
+	// MaxBackoff limits the wait time between two retries
+	MaxBackoff time.Duration
+	// Jitter is the fraction of the wait time that is randomized, e.g. 0.2 for +/- 20%
+	Jitter float64
+	// ReadyTimeout is the maximum time to wait for openHAB to finish starting, 0 disables the readiness check
+	ReadyTimeout time.Duration
+}
+
+// REST resources that are registered once openHAB finished starting the bundles used by this provider
+var readyResourceTypes = []string{"items", "links", "things", "rules"}
+
+// RetryDoer retries requests that failed because openHAB is (re)starting, e.g. due to 503 responses. Additionally,
+// a 404 of a GET request is only returned once openHAB is ready, because openHAB answers with 404 while its bundles
+// are starting and resources would otherwise be treated as deleted.
+type RetryDoer struct {
+	server  string
+	doer    HttpRequestDoer
+	options RetryOptions
+
+	mu    sync.Mutex
+	ready bool
+}
+
+// NewRetryDoer wraps the given doer, the server is used to check the readiness of openHAB.
+func NewRetryDoer(server string, doer HttpRequestDoer, options RetryOptions) *RetryDoer {
+	// same as NewClient, otherwise the last path segment is dropped when building requests
+	if !strings.HasSuffix(server, "/") {
+		server += "/"
+	}
+
+	return &RetryDoer{
+		server:  server,
+		doer:    doer,
+		options: options,
+	}
+}
+
+func (d *RetryDoer) Do(req *http.Request) (*http.Response, error) {
+	resp, err := d.doWithRetries(req)
+	if err != nil || resp.StatusCode != 404 || req.Method != http.MethodGet || d.isReadinessProbe(req) {
+		return resp, err
+	}
+
+	waited, err := d.waitUntilReady(req)
+	if err != nil {
+		_ = resp.Body.Close()
+		return nil, err
+	}
+	if !waited {
+		return resp, nil
+	}
+
+	// openHAB was not ready, so the 404 may be wrong
+	_ = resp.Body.Close()
+	return d.doWithRetries(req)
+}
+
+// doWithRetries sends the request and retries it as long as the response indicates a temporary failure.
+func (d *RetryDoer) doWithRetries(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		attemptReq, err := rewindRequest(req, attempt)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := d.doer.Do(attemptReq)
+		if attempt >= d.options.MaxRetries || !shouldRetry(req, resp, err) {
+			return resp, err
+		}
+
+		wait := d.backoff(attempt, resp)
+		if deadline, ok := req.Context().Deadline(); ok && time.Until(deadline) < wait {
+			// the request would time out while waiting, so the last result is returned instead
+			return resp, err
+		}
+		if resp != nil {
+			_, _ = io.Copy(ioutil.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+
+		if err := sleep(req.Context(), wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// waitUntilReady polls openHAB until it is ready, waited is true if openHAB was not ready on the first check.
+func (d *RetryDoer) waitUntilReady(req *http.Request) (waited bool, err error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.ready || d.options.ReadyTimeout <= 0 {
+		return false, nil
+	}
+
+	deadline := time.Now().Add(d.options.ReadyTimeout)
+	for attempt := 0; ; attempt++ {
+		if d.isReady(req) {
+			d.ready = true
+			return attempt > 0, nil
+		}
+
+		if time.Now().After(deadline) {
+			return true, fmt.Errorf("openHAB did not finish starting within %s", d.options.ReadyTimeout)
+		}
+
+		if err := sleep(req.Context(), d.backoff(attempt, nil)); err != nil {
+			return true, err
+		}
+	}
+}
+
+// isReady checks if the REST root lists all required resources and the system information is available.
+func (d *RetryDoer) isReady(req *http.Request) bool {
+	rootReq, err := NewGetRootRequest(d.server)
+	if err != nil {
+		return false
+	}
+
+	root := &RootBean{}
+	if !d.probe(req, rootReq, root) || root.Links == nil {
+		return false
+	}
+
+	resourceTypes := make(map[string]bool, len(*root.Links))
+	for _, link := range *root.Links {
+		if link.Type != nil {
+			resourceTypes[*link.Type] = true
+		}
+	}
+	for _, resourceType := range readyResourceTypes {
+		if !resourceTypes[resourceType] {
+			return false
+		}
+	}
+
+	systemInfoReq, err := NewGetSystemInformationRequest(d.server)
+	if err != nil {
+		return false
+	}
+
+	return d.probe(req, systemInfoReq, &SystemInfoBean{})
+}
+
+// probe sends the probe request using the credentials of the original request and decodes its response.
+func (d *RetryDoer) probe(req *http.Request, probeReq *http.Request, target interface{}) bool {
+	probeReq = probeReq.WithContext(req.Context())
+	if authorization := req.Header.Get("Authorization"); authorization != "" {
+		probeReq.Header.Set("Authorization", authorization)
+	}
+
+	resp, err := d.doer.Do(probeReq)
+	if err != nil {
+		return false
+	}
+	defer resp.Body.Close()
+
+	return resp.StatusCode == 200 && ReadResponseBody(resp, target) == nil
+}
+
+// isReadinessProbe returns true for requests of the resources used to check the readiness.
+func (d *RetryDoer) isReadinessProbe(req *http.Request) bool {
+	for _, newRequest := range []func(string) (*http.Request, error){NewGetRootRequest, NewGetSystemInformationRequest} {
+		probeReq, err := newRequest(d.server)
+		if err == nil && probeReq.URL.Path == req.URL.Path {
+			return true
+		}
+	}
+
+	return false
+}
+
+// backoff returns the wait time before the next attempt, a Retry-After header of the response takes precedence. Both
+// are limited by MaxBackoff, so a misconfigured server can not stall the provider.
+func (d *RetryDoer) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+			wait := time.Duration(seconds) * time.Second
+			if d.options.MaxBackoff > 0 && wait > d.options.MaxBackoff {
+				wait = d.options.MaxBackoff
+			}
+			return wait
+		}
+	}
+
+	wait := float64(d.options.Backoff) * math.Pow(2, float64(attempt))
+	if d.options.MaxBackoff > 0 && wait > float64(d.options.MaxBackoff) {
+		wait = float64(d.options.MaxBackoff)
+	}
+	if d.options.Jitter > 0 {
+		wait += wait * d.options.Jitter * (2*rand.Float64() - 1)
+	}
+
+	return time.Duration(wait)
+}
+
+// shouldRetry returns true if the request failed temporarily. Requests that are not idempotent are only retried if
+// openHAB did not process them.
+func shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if req.Context().Err() != nil {
+		return false
+	}
+
+	idempotent := req.Method != http.MethodPost && req.Method != http.MethodPatch
+	if err != nil {
+		return idempotent
+	}
+
+	switch resp.StatusCode {
+	case 429, 503:
+		return true
+	case 502, 504:
+		return idempotent
+	}
+
+	return false
+}
+
+// rewindRequest returns a request with a fresh body for every retry.
+func rewindRequest(req *http.Request, attempt int) (*http.Request, error) {
+	if attempt == 0 || req.Body == nil || req.GetBody == nil {
+		return req, nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+
+	attemptReq := req.Clone(req.Context())
+	attemptReq.Body = body
+
+	return attemptReq, nil
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
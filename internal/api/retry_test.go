@@ -0,0 +1,207 @@
+package api
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func testRetryOptions() RetryOptions {
+	return RetryOptions{
+		MaxRetries:   3,
+		Backoff:      time.Millisecond,
+		MaxBackoff:   5 * time.Millisecond,
+		Jitter:       0.2,
+		ReadyTimeout: time.Second,
+	}
+}
+
+// testRetryClient creates a client for the given handler whose requests are sent using a RetryDoer.
+func testRetryClient(t *testing.T, options RetryOptions, handler http.HandlerFunc) *Client {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client, err := NewClient(server.URL+"/rest",
+		WithHTTPClient(NewRetryDoer(server.URL+"/rest", &http.Client{}, options)))
+	if err != nil {
+		t.Fatalf("unable to create client: %s", err)
+	}
+
+	return client
+}
+
+func TestRetryDoerRetriesUnavailableServer(t *testing.T) {
+	var attempts int32
+	client := testRetryClient(t, testRetryOptions(), func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) == "" {
+			t.Errorf("expected body to be sent on every attempt")
+		}
+
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	name := "test_item"
+	apiResp, err := client.AddOrUpdateItemInRegistry(context.Background(), name, &AddOrUpdateItemInRegistryParams{},
+		AddOrUpdateItemInRegistryJSONRequestBody{Name: &name})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if apiResp.StatusCode != http.StatusOK || attempts != 3 {
+		t.Errorf("expected request to succeed after 3 attempts, got status %d after %d attempts", apiResp.StatusCode, attempts)
+	}
+}
+
+func TestRetryDoerStopsAfterMaxRetries(t *testing.T) {
+	var attempts int32
+	client := testRetryClient(t, testRetryOptions(), func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	apiResp, err := client.GetItems(context.Background(), &GetItemsParams{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if apiResp.StatusCode != http.StatusServiceUnavailable || attempts != 4 {
+		t.Errorf("expected last response after 4 attempts, got status %d after %d attempts", apiResp.StatusCode, attempts)
+	}
+}
+
+func TestRetryDoerDoesNotRepeatProcessedPost(t *testing.T) {
+	var attempts int32
+	client := testRetryClient(t, testRetryOptions(), func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	_, err := client.CreateRule(context.Background(), CreateRuleJSONRequestBody{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if attempts != 1 {
+		t.Errorf("expected POST to be sent once, got %d attempts", attempts)
+	}
+}
+
+func TestRetryDoerWaitsForReadinessBeforeNotFound(t *testing.T) {
+	var rootRequests int32
+	client := testRetryClient(t, testRetryOptions(), func(w http.ResponseWriter, r *http.Request) {
+		started := atomic.LoadInt32(&rootRequests) > 2
+
+		switch r.URL.Path {
+		case "/rest/":
+			atomic.AddInt32(&rootRequests, 1)
+			if started {
+				_, _ = w.Write([]byte(`{"version": "4", "links": [{"type": "items"}, {"type": "links"}, {"type": "things"}, {"type": "rules"}]}`))
+			} else {
+				_, _ = w.Write([]byte(`{"version": "4", "links": [{"type": "items"}]}`))
+			}
+		case "/rest/systeminfo":
+			_, _ = w.Write([]byte(`{"systemInfo": {}}`))
+		case "/rest/items/test_item":
+			if started {
+				_, _ = w.Write([]byte(`{"name": "test_item"}`))
+			} else {
+				w.WriteHeader(http.StatusNotFound)
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	apiResp, err := client.GetItemByName(context.Background(), "test_item", &GetItemByNameParams{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if apiResp.StatusCode != http.StatusOK {
+		t.Errorf("expected item to be found once openHAB is ready, got status %d", apiResp.StatusCode)
+	}
+
+	// once ready, a 404 is returned without further checks
+	requestsBefore := atomic.LoadInt32(&rootRequests)
+	apiResp, err = client.GetItemByName(context.Background(), "missing_item", &GetItemByNameParams{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if apiResp.StatusCode != http.StatusNotFound || atomic.LoadInt32(&rootRequests) != requestsBefore {
+		t.Errorf("expected 404 of a ready server to be returned directly, got status %d", apiResp.StatusCode)
+	}
+}
+
+func TestRetryDoerFailsIfNeverReady(t *testing.T) {
+	options := testRetryOptions()
+	options.ReadyTimeout = 20 * time.Millisecond
+
+	client := testRetryClient(t, options, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	if _, err := client.GetItemByName(context.Background(), "test_item", &GetItemByNameParams{}); err == nil {
+		t.Errorf("expected error instead of 404 if openHAB never becomes ready")
+	}
+}
+
+func TestRetryDoerLimitsRetryAfter(t *testing.T) {
+	var attempts int32
+	client := testRetryClient(t, testRetryOptions(), func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) < 2 {
+			w.Header().Set("Retry-After", "86400")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	start := time.Now()
+	apiResp, err := client.GetItems(context.Background(), &GetItemsParams{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if apiResp.StatusCode != http.StatusOK || attempts != 2 {
+		t.Errorf("expected request to succeed after 2 attempts, got status %d after %d attempts", apiResp.StatusCode, attempts)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("expected Retry-After to be limited by the max backoff, waited %s", elapsed)
+	}
+}
+
+func TestRetryDoerDoesNotWaitBeyondDeadline(t *testing.T) {
+	options := testRetryOptions()
+	options.MaxBackoff = time.Hour
+
+	var attempts int32
+	client := testRetryClient(t, options, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	start := time.Now()
+	apiResp, err := client.GetItems(ctx, &GetItemsParams{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if apiResp.StatusCode != http.StatusServiceUnavailable || attempts != 1 {
+		t.Errorf("expected last response without retry, got status %d after %d attempts", apiResp.StatusCode, attempts)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("expected no wait beyond the deadline, waited %s", elapsed)
+	}
+}
@@ -0,0 +1,296 @@
+package provider
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"github.com/chris922/terraform-provider-openhab/internal/api"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// newClient creates the API client for the given provider configuration. API tokens are sent as bearer token,
+// username and password using basic authentication.
+func newClient(data providerData) (*api.Client, error) {
+	tlsConfig, err := newTLSConfig(data)
+	if err != nil {
+		return nil, err
+	}
+
+	retryOptions, err := newRetryOptions(data)
+	if err != nil {
+		return nil, err
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	// every attempt of a retried request is logged
+	doer := api.NewRetryDoer(data.Endpoint.Value, api.NewLoggingDoer(&http.Client{Transport: transport}), retryOptions)
+
+	return api.NewClient(data.Endpoint.Value,
+		api.WithHTTPClient(doer),
+		api.WithRequestEditorFn(authRequestEditor(data)))
+}
+
+// newRetryOptions returns the configured retry options, attributes that are not set use the defaults.
+func newRetryOptions(data providerData) (api.RetryOptions, error) {
+	options := api.RetryOptions{
+		MaxRetries:   5,
+		Backoff:      time.Second,
+		MaxBackoff:   30 * time.Second,
+		Jitter:       0.2,
+		ReadyTimeout: 5 * time.Minute,
+	}
+
+	if !data.MaxRetries.Null {
+		options.MaxRetries = int(data.MaxRetries.Value)
+	}
+	if !data.RetryJitter.Null {
+		options.Jitter = data.RetryJitter.Value
+	}
+
+	for _, duration := range []struct {
+		name   string
+		value  types.String
+		target *time.Duration
+	}{
+		{"retry_backoff", data.RetryBackoff, &options.Backoff},
+		{"retry_max_backoff", data.RetryMaxBackoff, &options.MaxBackoff},
+		{"ready_timeout", data.ReadyTimeout, &options.ReadyTimeout},
+	} {
+		if duration.value.Null {
+			continue
+		}
+
+		d, err := time.ParseDuration(duration.value.Value)
+		if err != nil {
+			return options, fmt.Errorf("unable to parse %s: %s", duration.name, err)
+		}
+		*duration.target = d
+	}
+
+	return options, nil
+}
+
+// authRequestEditor adds the configured credentials to every request.
+func authRequestEditor(data providerData) api.RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		if !data.ApiToken.Null {
+			req.Header.Set("Authorization", "Bearer "+data.ApiToken.Value)
+		} else {
+			req.SetBasicAuth(data.Username.Value, data.Password.Value)
+		}
+		return nil
+	}
+}
+
+// newTLSConfig creates the TLS configuration out of the configured CA bundle and client certificate. Without a CA
+// bundle the system certificates are used.
+func newTLSConfig(data providerData) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: !data.InsecureSkipVerify.Null && data.InsecureSkipVerify.Value,
+	}
+
+	caCert, err := pemFromFileOrValue(data.CaCertFile, data.CaCertPem)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read CA certificate: %s", err)
+	}
+	if caCert != nil {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("unable to parse CA certificate, expected PEM encoded certificates")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	clientCert, err := pemFromFileOrValue(data.ClientCertFile, data.ClientCertPem)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read client certificate: %s", err)
+	}
+	clientKey, err := pemFromFileOrValue(data.ClientKeyFile, data.ClientKeyPem)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read client key: %s", err)
+	}
+	if clientCert != nil && clientKey != nil {
+		certificate, err := tls.X509KeyPair(clientCert, clientKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse client certificate and key: %s", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	return tlsConfig, nil
+}
+
+// pemFromFileOrValue returns the content of the given file or the given PEM value, nil if none of them is set.
+func pemFromFileOrValue(file types.String, value types.String) ([]byte, error) {
+	if !file.Null && file.Value != "" {
+		return os.ReadFile(file.Value)
+	}
+	if !value.Null && value.Value != "" {
+		return []byte(value.Value), nil
+	}
+
+	return nil, nil
+}
+
+// serverInfo describes the openHAB server the provider is connected to.
+type serverInfo struct {
+	uuid    string
+	version *serverVersion
+}
+
+// verifyClient checks that the endpoint is the REST root of an openHAB server and that the credentials grant admin
+// access. An endpoint without `/rest` suffix is normalized, the returned client uses the normalized endpoint. The
+// UUID and version of the server are returned as well.
+func verifyClient(ctx context.Context, data providerData) (*api.Client, serverInfo, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	endpoints := []string{data.Endpoint.Value}
+	if trimmed := strings.TrimSuffix(data.Endpoint.Value, "/"); !strings.HasSuffix(trimmed, "/rest") {
+		endpoints = append(endpoints, trimmed+"/rest")
+	}
+
+	var client *api.Client
+	var info serverInfo
+	for _, endpoint := range endpoints {
+		data.Endpoint = types.String{Value: endpoint}
+
+		candidate, err := newClient(data)
+		if err != nil {
+			diags.AddError("Client Error",
+				fmt.Sprintf("Unable to create client, got error: %s", err))
+			return nil, serverInfo{}, diags
+		}
+
+		apiResp, err := candidate.GetRoot(ctx)
+		if err != nil {
+			diags.AddAttributeError(tftypes.NewAttributePath().WithAttributeName("endpoint"),
+				"Unreachable openHAB Server",
+				fmt.Sprintf("Unable to connect to %s, got error: %s", endpoint, err))
+			return nil, serverInfo{}, diags
+		}
+
+		if apiResp.StatusCode == 401 {
+			_ = apiResp.Body.Close()
+			diags.AddError("Unauthorized",
+				fmt.Sprintf("The openHAB server at %s rejected the configured credentials, check if the API "+
+					"token is expired or username and password are correct.", endpoint))
+			return nil, serverInfo{}, diags
+		}
+
+		root := &api.RootBean{}
+		if apiResp.StatusCode == 200 && api.ReadResponseBody(apiResp, root) == nil &&
+			root.Version != nil && root.Links != nil {
+			if endpoint != endpoints[0] {
+				tflog.Info(ctx, "Endpoint is not the REST root of openHAB, using normalized endpoint",
+					map[string]interface{}{"endpoint": endpoints[0], "normalized_endpoint": endpoint})
+			}
+
+			client = candidate
+			version, versionDiags := verifyServerVersion(root)
+			diags.Append(versionDiags...)
+			info.version = version
+			break
+		}
+		_ = apiResp.Body.Close()
+	}
+
+	if client == nil {
+		diags.AddAttributeError(tftypes.NewAttributePath().WithAttributeName("endpoint"),
+			"Invalid openHAB Endpoint",
+			fmt.Sprintf("The endpoint %s is not the REST API root of an openHAB server, it usually ends with "+
+				"`/rest`, e.g. `http://openhab:8080/rest`.", endpoints[0]))
+		return nil, serverInfo{}, diags
+	}
+
+	// reading the UUID requires a valid user, reading links requires admin rights like all managed resources
+	apiResp, err := client.GetUUID(ctx)
+	if err != nil {
+		diags.AddError("Unreachable openHAB Server",
+			fmt.Sprintf("Unable to read the server UUID, got error: %s", err))
+		return nil, serverInfo{}, diags
+	}
+	diags.Append(verifyAccess(apiResp, "read the server UUID")...)
+	if diags.HasError() {
+		_ = apiResp.Body.Close()
+		return nil, serverInfo{}, diags
+	}
+	serverUuid, err := ioutil.ReadAll(apiResp.Body)
+	_ = apiResp.Body.Close()
+	if err != nil {
+		diags.AddError("Unexpected openHAB Response",
+			fmt.Sprintf("Unable to read the server UUID, got error: %s", err))
+		return nil, serverInfo{}, diags
+	}
+
+	apiResp, err = client.GetItemLinks(ctx, &api.GetItemLinksParams{})
+	if err != nil {
+		diags.AddError("Unreachable openHAB Server",
+			fmt.Sprintf("Unable to read links, got error: %s", err))
+		return nil, serverInfo{}, diags
+	}
+	diags.Append(verifyAccess(apiResp, "read links")...)
+	_ = apiResp.Body.Close()
+	if diags.HasError() {
+		return nil, serverInfo{}, diags
+	}
+
+	info.uuid = strings.TrimSpace(string(serverUuid))
+
+	return client, info, diags
+}
+
+// verifyServerVersion reads the openHAB version of the REST root and warns about versions that are not supported.
+func verifyServerVersion(root *api.RootBean) (*serverVersion, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	version, err := serverVersionFromRoot(root)
+	if err != nil {
+		diags.AddWarning("Unknown openHAB Version",
+			fmt.Sprintf("Unable to detect the openHAB version, all features are assumed to be supported, "+
+				"got error: %s", err))
+		return nil, diags
+	}
+
+	if !version.atLeast(minimumServerVersion) {
+		diags.AddWarning("Unsupported openHAB Version",
+			fmt.Sprintf("The server runs openHAB %s, but openHAB %s or newer is required. Resources may fail "+
+				"with unexpected errors.", version, minimumServerVersion.String()))
+	}
+
+	return version, diags
+}
+
+// verifyAccess converts the status of an authenticated request into a diagnostic describing the failure. It has to be
+// called before the body is closed, unexpected responses are reported with the error message of openHAB.
+func verifyAccess(apiResp *http.Response, action string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch apiResp.StatusCode {
+	case 200:
+	case 401:
+		diags.AddError("Unauthorized",
+			fmt.Sprintf("Unable to %s, the configured credentials were rejected. Check if the API token is "+
+				"expired or username and password are correct.", action))
+	case 403:
+		diags.AddError("Insufficient Permissions",
+			fmt.Sprintf("Unable to %s, the configured credentials lack admin rights. Create the API token "+
+				"using an administrator account.", action))
+	default:
+		diags.AddError("Unexpected openHAB Response",
+			fmt.Sprintf("Unable to %s, got error: %s", action, api.ReadResponseError(apiResp)))
+	}
+
+	return diags
+}
@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/chris922/terraform-provider-openhab/internal/api"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testClientCertificate creates a self-signed client certificate and returns it and its key PEM encoded.
+func testClientCertificate(t *testing.T) (certPem []byte, keyPem []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("unable to generate key: %s", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("unable to create certificate: %s", err)
+	}
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("unable to marshal key: %s", err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+}
+
+// testTLSServer starts a TLS server answering every request with an empty item list, if clientCaPem is set the
+// server requires a client certificate signed by it.
+func testTLSServer(t *testing.T, clientCaPem []byte) (server *httptest.Server, caPem []byte) {
+	server = httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[]`))
+	}))
+
+	if clientCaPem != nil {
+		pool := x509.NewCertPool()
+		pool.AppendCertsFromPEM(clientCaPem)
+		server.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: pool}
+	}
+
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	return server, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+}
+
+func testClientRequest(t *testing.T, data providerData) error {
+	client, err := newClient(data)
+	if err != nil {
+		t.Fatalf("unable to create client: %s", err)
+	}
+
+	apiResp, err := client.GetItems(context.Background(), &api.GetItemsParams{})
+	if err != nil {
+		return err
+	}
+
+	return apiResp.Body.Close()
+}
+
+func TestClientVerifiesServerCertificate(t *testing.T) {
+	server, caPem := testTLSServer(t, nil)
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(caFile, caPem, 0600); err != nil {
+		t.Fatalf("unable to write CA file: %s", err)
+	}
+
+	data := testProviderData()
+	data.Endpoint = types.String{Value: server.URL}
+	data.ApiToken = types.String{Value: "oh.terraform.secret"}
+
+	if err := testClientRequest(t, data); err == nil {
+		t.Errorf("expected request to fail without CA certificate")
+	}
+
+	data.CaCertPem = types.String{Value: string(caPem)}
+	if err := testClientRequest(t, data); err != nil {
+		t.Errorf("expected request to succeed using CA certificate PEM, got error: %s", err)
+	}
+
+	data.CaCertPem = types.String{Null: true}
+	data.CaCertFile = types.String{Value: caFile}
+	if err := testClientRequest(t, data); err != nil {
+		t.Errorf("expected request to succeed using CA certificate file, got error: %s", err)
+	}
+
+	data.CaCertFile = types.String{Null: true}
+	data.InsecureSkipVerify = types.Bool{Value: true}
+	if err := testClientRequest(t, data); err != nil {
+		t.Errorf("expected request to succeed skipping verification, got error: %s", err)
+	}
+}
+
+func TestClientSendsClientCertificate(t *testing.T) {
+	certPem, keyPem := testClientCertificate(t)
+	server, caPem := testTLSServer(t, certPem)
+
+	data := testProviderData()
+	data.Endpoint = types.String{Value: server.URL}
+	data.ApiToken = types.String{Value: "oh.terraform.secret"}
+	data.CaCertPem = types.String{Value: string(caPem)}
+
+	if err := testClientRequest(t, data); err == nil {
+		t.Errorf("expected request to fail without client certificate")
+	}
+
+	data.ClientCertPem = types.String{Value: string(certPem)}
+	data.ClientKeyPem = types.String{Value: string(keyPem)}
+	if err := testClientRequest(t, data); err != nil {
+		t.Errorf("expected request to succeed using client certificate, got error: %s", err)
+	}
+}
+
+func TestClientRejectsInvalidCertificates(t *testing.T) {
+	data := testProviderData()
+	data.Endpoint = types.String{Value: "https://openhab:8443/rest"}
+	data.CaCertPem = types.String{Value: "no certificate"}
+
+	if _, err := newClient(data); err == nil {
+		t.Errorf("expected invalid CA certificate to be rejected")
+	}
+
+	data.CaCertPem = types.String{Null: true}
+	data.CaCertFile = types.String{Value: filepath.Join(t.TempDir(), "missing.pem")}
+
+	if _, err := newClient(data); err == nil {
+		t.Errorf("expected missing CA certificate file to be rejected")
+	}
+}
+
+func TestVerifyAccessReportsServerMessage(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	recorder.Header().Set("Content-Type", "application/json")
+	recorder.WriteHeader(http.StatusInternalServerError)
+	_, _ = recorder.WriteString(`{"error": {"message": "Link registry is not available", "http-code": 500}}`)
+
+	diags := verifyAccess(recorder.Result(), "read links")
+	if !diags.HasError() {
+		t.Fatalf("expected error for unexpected status")
+	}
+	detail := diags[0].Detail()
+	if !strings.Contains(detail, "500") || !strings.Contains(detail, "Link registry is not available") {
+		t.Errorf("expected error to contain status and message of openHAB, got %q", detail)
+	}
+}
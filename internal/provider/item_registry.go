@@ -0,0 +1,220 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/chris922/terraform-provider-openhab/internal/api"
+	"github.com/chris922/terraform-provider-openhab/internal/provider/util"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// itemRegistry creates, reads, updates and deletes items in the item registry of openHAB. It is shared by all
+// resources managing items, they convert their resource data to an itemResourceData and back.
+type itemRegistry struct {
+	client    *api.Client
+	safeguard *safeguard
+	defaults  itemDefaults
+	ownership ownership
+}
+
+func newItemRegistry(provider OpenhabProvider) itemRegistry {
+	return itemRegistry{
+		client:    provider.Client,
+		safeguard: provider.safeguard,
+		defaults:  provider.itemDefaults,
+		ownership: provider.ownership,
+	}
+}
+
+// createItem creates the item and marks the workspace as its owner. The created item is stored in the given data.
+func (r itemRegistry) createItem(ctx context.Context, data *itemResourceData) diag.Diagnostics {
+	diags := r.ownership.checkItemCreatable(ctx, r.client, data.Name.Value, r.ownership.adopt(data.AdoptExisting))
+	if diags.HasError() {
+		return diags
+	}
+
+	apiResp, err := r.client.AddOrUpdateItemInRegistry(ctx, data.Name.Value,
+		&api.AddOrUpdateItemInRegistryParams{}, r.itemDataToBody(*data))
+	if err != nil {
+		diags.AddError("Create Item Error",
+			fmt.Sprintf("Unable to create item %s, got error: %s", data.Name.Value, err))
+		return diags
+	}
+
+	if apiResp.StatusCode == 200 {
+		diags.AddWarning("Create Item Warning",
+			fmt.Sprintf("Item %s was not created, but updated", data.Name.Value))
+	} else if apiResp.StatusCode != 201 {
+		diags.AddError("Create Item Error",
+			fmt.Sprintf("Unable to create item %s, got error: %s", data.Name.Value, api.ReadResponseError(apiResp)))
+		return diags
+	}
+
+	apiRespObj := &api.EnrichedItemDTO{}
+	err = api.ReadResponseBody(apiResp, apiRespObj)
+	if err != nil {
+		diags.AddError("Create Item Error",
+			fmt.Sprintf("Unable to read response of creating item %s, got error: %s", data.Name.Value, err))
+		return diags
+	}
+
+	// store enriched item to resource
+	enrichedItemToData(data, apiRespObj, r.defaults)
+	data.ServerUuid = r.safeguard.serverUuidValue()
+
+	diags.Append(r.ownership.markItemOwner(ctx, r.client, data.Name.Value)...)
+
+	return diags
+}
+
+// readItem reads the item and stores it in the given data. Found is false if the item does not exist anymore and can
+// be removed from the state, the safeguard fails instead if the server seems to be the wrong one. The item returned by
+// openHAB is returned as well to read the requested metadata.
+func (r itemRegistry) readItem(ctx context.Context, data *itemResourceData, params *api.GetItemByNameParams) (*api.EnrichedItemDTO, bool, diag.Diagnostics) {
+	diags := r.safeguard.checkServer("item", data.Name.Value, data.ServerUuid)
+	if diags.HasError() {
+		return nil, false, diags
+	}
+
+	apiResp, err := r.client.GetItemByName(ctx, data.Name.Value, params)
+	if err != nil {
+		diags.AddError("Read Item Error",
+			fmt.Sprintf("Unable to read item %s, got error: %s", data.Name.Value, err))
+		return nil, false, diags
+	}
+
+	if apiResp.StatusCode == 404 {
+		diags.Append(r.safeguard.missing(ctx, "item", data.Name.Value)...)
+		if diags.HasError() {
+			return nil, false, diags
+		}
+
+		tflog.Debug(ctx, "Item not found, will be removed from state", map[string]interface{}{"name": data.Name.Value})
+
+		return nil, false, diags
+	}
+	if apiResp.StatusCode != 200 {
+		diags.AddError("Read Item Error",
+			fmt.Sprintf("Unable to read item %s, got error: %s", data.Name.Value, api.ReadResponseError(apiResp)))
+		return nil, false, diags
+	}
+
+	apiRespObj := &api.EnrichedItemDTO{}
+	err = api.ReadResponseBody(apiResp, apiRespObj)
+	if err != nil {
+		diags.AddError("Read Item Error",
+			fmt.Sprintf("Unable to read response of reading item %s, got error: %s", data.Name.Value, err))
+		return nil, false, diags
+	}
+
+	r.safeguard.found("item")
+
+	// store enriched item to resource
+	enrichedItemToData(data, apiRespObj, r.defaults)
+	data.ServerUuid = r.safeguard.keepServerUuid(data.ServerUuid)
+
+	return apiRespObj, true, diags
+}
+
+// updateItem updates the item and stores it in the given data. A renamed item is created under its new name, so
+// creating it is only unexpected if the item keeps its name. Items created before the ownership was recorded are
+// marked on their next update.
+func (r itemRegistry) updateItem(ctx context.Context, data *itemResourceData, state itemResourceData) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	apiResp, err := r.client.AddOrUpdateItemInRegistry(ctx, data.Name.Value,
+		&api.AddOrUpdateItemInRegistryParams{}, r.itemDataToBody(*data))
+	if err != nil {
+		diags.AddError("Update Item Error",
+			fmt.Sprintf("Unable to update item %s, got error: %s", data.Name.Value, err))
+		return diags
+	}
+
+	if apiResp.StatusCode == 201 && data.Name.Value == state.Name.Value {
+		diags.AddWarning("Update Item Warning",
+			fmt.Sprintf("Item %s was not updated, but created", data.Name.Value))
+	} else if apiResp.StatusCode != 200 && apiResp.StatusCode != 201 {
+		diags.AddError("Update Item Error",
+			fmt.Sprintf("Unable to update item %s, got error: %s", data.Name.Value, api.ReadResponseError(apiResp)))
+		return diags
+	}
+
+	apiRespObj := &api.EnrichedItemDTO{}
+	err = api.ReadResponseBody(apiResp, apiRespObj)
+	if err != nil {
+		diags.AddError("Update Item Error",
+			fmt.Sprintf("Unable to read response of updating item %s, got error: %s", data.Name.Value, err))
+		return diags
+	}
+
+	// store enriched item to resource
+	enrichedItemToData(data, apiRespObj, r.defaults)
+	data.ServerUuid = r.safeguard.keepServerUuid(state.ServerUuid)
+
+	diags.Append(r.ownership.markItemOwner(ctx, r.client, data.Name.Value)...)
+
+	return diags
+}
+
+// deleteItem removes the item unless it is owned by another workspace, an already removed item is ignored.
+func (r itemRegistry) deleteItem(ctx context.Context, itemName string) diag.Diagnostics {
+	diags := r.ownership.checkItemDeletable(ctx, r.client, itemName)
+	if diags.HasError() {
+		return diags
+	}
+
+	apiResp, err := r.client.RemoveItemFromRegistry(ctx, itemName)
+	if err != nil {
+		diags.AddError("Delete Item Error",
+			fmt.Sprintf("Unable to delete item %s, got error: %s", itemName, err))
+		return diags
+	}
+
+	if apiResp.StatusCode == 404 {
+		tflog.Debug(ctx, "Planned to remove an item, but it was already removed", map[string]interface{}{"name": itemName})
+	} else if apiResp.StatusCode != 200 {
+		diags.AddError("Delete Item Error",
+			fmt.Sprintf("Unable to delete item %s, got error: %s", itemName, api.ReadResponseError(apiResp)))
+	}
+
+	return diags
+}
+
+// updateMetadata removes namespaces that are no longer managed and puts all planned namespaces to the item.
+func (r itemRegistry) updateMetadata(ctx context.Context, itemName string, prior map[string]itemMetadataData,
+	planned map[string]itemMetadataData) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for namespace := range prior {
+		if _, ok := planned[namespace]; !ok {
+			diags.Append(removeItemMetadata(ctx, r.client, itemName, namespace)...)
+		}
+	}
+
+	for namespace, metadata := range planned {
+		if priorMetadata, ok := prior[namespace]; ok && priorMetadata == metadata {
+			continue
+		}
+
+		diags.Append(putItemMetadata(ctx, r.client, itemName, namespace, metadata.Value, metadata.Config)...)
+	}
+
+	return diags
+}
+
+// itemDataToBody converts the resource data to the item sent to openHAB, the default tags and groups are added.
+// A default group is not added to the item of the same name.
+func (r itemRegistry) itemDataToBody(data itemResourceData) api.AddOrUpdateItemInRegistryJSONRequestBody {
+	return api.AddOrUpdateItemInRegistryJSONRequestBody{
+		Name:  util.TypeToString(data.Name),
+		Label: util.TypeToString(data.Label),
+		Type:  util.TypeToString(data.Type),
+
+		Category:   util.TypeToString(data.Category),
+		Tags:       util.MergeStringArrays(util.TypeToStringArray(data.Tags), r.defaults.tags),
+		GroupNames: util.MergeStringArrays(util.TypeToStringArray(data.GroupNames), r.defaults.groupNamesOf(data.Name.Value)),
+		GroupType:  util.TypeToString(data.GroupType),
+		Function:   groupFunctionDataToDTO(data.Function),
+	}
+}
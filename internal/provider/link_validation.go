@@ -0,0 +1,279 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/chris922/terraform-provider-openhab/internal/api"
+	"github.com/chris922/terraform-provider-openhab/internal/provider/util"
+	"github.com/chris922/terraform-provider-openhab/internal/provider/validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strings"
+)
+
+// linkChannel contains the parts of a channel that are relevant to validate a link.
+type linkChannel struct {
+	channelTypeUid string
+	itemType       string
+	kind           string
+}
+
+// validateLinkCompatibility checks if the item can be linked to the channel using the given profile. Items and things
+// that do not exist yet are skipped, they may be created in the same run.
+func validateLinkCompatibility(ctx context.Context, client *api.Client, itemName string, channelUid string,
+	profile string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	itemType, found, err := getLinkItemType(ctx, client, itemName)
+	if err != nil {
+		diags.AddError("Validate Link Error",
+			fmt.Sprintf("Unable to read item %s, got error: %s", itemName, err))
+		return diags
+	}
+	if !found || itemType == "" {
+		tflog.Debug(ctx, "Item of link not found, skipping validation", map[string]interface{}{"item_name": itemName})
+		return diags
+	}
+
+	channel, found, err := getLinkChannel(ctx, client, channelUid)
+	if err != nil {
+		diags.AddError("Validate Link Error",
+			fmt.Sprintf("Unable to read channel %s, got error: %s", channelUid, err))
+		return diags
+	}
+	if !found {
+		tflog.Debug(ctx, "Channel of link not found, skipping validation", map[string]interface{}{"channel_uid": channelUid})
+		return diags
+	}
+
+	if channel.kind == "TRIGGER" {
+		return validateTriggerLink(ctx, client, itemType, channelUid, channel, profile)
+	}
+
+	// other profiles like `system:follow` or transformations convert the states
+	if (profile == "" || profile == "system:default") && channel.itemType != "" {
+		if err := validator.ValidateLinkItemType(itemType, channel.itemType); err != nil {
+			diags.AddError("Incompatible Link",
+				fmt.Sprintf("Unable to link item %s to channel %s, %s", itemName, channelUid, err))
+		}
+	}
+
+	return diags
+}
+
+// validateTriggerLink checks if the item can be linked to the trigger channel and the profile is a trigger profile
+// supported by the channel type.
+func validateTriggerLink(ctx context.Context, client *api.Client, itemType string, channelUid string,
+	channel linkChannel, profile string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if profile == "" {
+		diags.AddError("Incompatible Link",
+			fmt.Sprintf("Channel %s is a trigger channel, a trigger profile has to be set", channelUid))
+		return diags
+	}
+	if channel.channelTypeUid == "" {
+		return diags
+	}
+
+	apiResp, err := client.GetLinkableItemTypesByChannelTypeUID(ctx, channel.channelTypeUid)
+	if err != nil {
+		diags.AddError("Validate Link Error",
+			fmt.Sprintf("Unable to read linkable item types of channel type %s, got error: %s", channel.channelTypeUid, err))
+		return diags
+	}
+	if apiResp.StatusCode != 200 {
+		diags.AddError("Validate Link Error",
+			fmt.Sprintf("Unable to read linkable item types of channel type %s, got error: %s", channel.channelTypeUid, api.ReadResponseError(apiResp)))
+		return diags
+	}
+
+	var linkableItemTypes []string
+	if err := api.ReadResponseBody(apiResp, &linkableItemTypes); err != nil {
+		diags.AddError("Validate Link Error",
+			fmt.Sprintf("Unable to read response of linkable item types action, got error: %s", err))
+		return diags
+	}
+
+	baseType, _ := validator.SplitItemType(itemType)
+	if !util.StringArrayContains(linkableItemTypes, itemType) && !util.StringArrayContains(linkableItemTypes, baseType) {
+		diags.AddError("Incompatible Link",
+			fmt.Sprintf("Unable to link item of type %s to trigger channel %s, expected one of %s",
+				itemType, channelUid, strings.Join(linkableItemTypes, ", ")))
+		return diags
+	}
+
+	apiResp, err = client.GetProfileTypes(ctx, &api.GetProfileTypesParams{
+		ChannelTypeUID: &channel.channelTypeUid,
+		ItemType:       &baseType,
+	})
+	if err != nil {
+		diags.AddError("Validate Link Error",
+			fmt.Sprintf("Unable to read profile types of channel type %s, got error: %s", channel.channelTypeUid, err))
+		return diags
+	}
+	if apiResp.StatusCode != 200 {
+		diags.AddError("Validate Link Error",
+			fmt.Sprintf("Unable to read profile types of channel type %s, got error: %s", channel.channelTypeUid, api.ReadResponseError(apiResp)))
+		return diags
+	}
+
+	var profileTypes []api.ProfileTypeDTO
+	if err := api.ReadResponseBody(apiResp, &profileTypes); err != nil {
+		diags.AddError("Validate Link Error",
+			fmt.Sprintf("Unable to read response of profile types action, got error: %s", err))
+		return diags
+	}
+
+	var triggerProfiles []string
+	for _, profileType := range profileTypes {
+		if profileType.Uid != nil && profileType.Kind != nil && *profileType.Kind == "TRIGGER" {
+			triggerProfiles = append(triggerProfiles, *profileType.Uid)
+		}
+	}
+
+	if !util.StringArrayContains(triggerProfiles, profile) {
+		diags.AddError("Incompatible Link",
+			fmt.Sprintf("Profile %s can not be used to link an item of type %s to trigger channel %s, expected one of %s",
+				profile, itemType, channelUid, strings.Join(triggerProfiles, ", ")))
+	}
+
+	return diags
+}
+
+// getLinkItemType returns the type of the item, for groups the group type is used.
+func getLinkItemType(ctx context.Context, client *api.Client, itemName string) (itemType string, found bool, err error) {
+	apiResp, err := client.GetItemByName(ctx, itemName, &api.GetItemByNameParams{})
+	if err != nil {
+		return "", false, err
+	}
+	if apiResp.StatusCode == 404 {
+		return "", false, nil
+	}
+	if apiResp.StatusCode != 200 {
+		return "", false, api.ReadResponseError(apiResp)
+	}
+
+	apiRespObj := &api.EnrichedItemDTO{}
+	if err := api.ReadResponseBody(apiResp, apiRespObj); err != nil {
+		return "", false, err
+	}
+
+	if apiRespObj.Type != nil && *apiRespObj.Type == "Group" {
+		if apiRespObj.GroupType == nil {
+			return "", true, nil
+		}
+		return *apiRespObj.GroupType, true, nil
+	}
+	if apiRespObj.Type == nil {
+		return "", true, nil
+	}
+
+	return *apiRespObj.Type, true, nil
+}
+
+// getLinkChannel reads the channel from its thing, the channel type is only requested if the thing does not provide
+// the item type or kind of the channel.
+func getLinkChannel(ctx context.Context, client *api.Client, channelUid string) (channel linkChannel, found bool, err error) {
+	separator := strings.LastIndex(channelUid, ":")
+	if separator <= 0 {
+		return linkChannel{}, false, fmt.Errorf("channel UID %s does not contain a thing UID", channelUid)
+	}
+	thingUid := channelUid[:separator]
+
+	apiResp, err := client.GetThingById(ctx, thingUid, &api.GetThingByIdParams{})
+	if err != nil {
+		return linkChannel{}, false, err
+	}
+	if apiResp.StatusCode == 404 {
+		return linkChannel{}, false, nil
+	}
+	if apiResp.StatusCode != 200 {
+		return linkChannel{}, false, api.ReadResponseError(apiResp)
+	}
+
+	apiRespObj := &api.EnrichedThingDTO{}
+	if err := api.ReadResponseBody(apiResp, apiRespObj); err != nil {
+		return linkChannel{}, false, err
+	}
+
+	if apiRespObj.Channels != nil {
+		for _, c := range *apiRespObj.Channels {
+			if c.Uid == nil || *c.Uid != channelUid {
+				continue
+			}
+
+			if c.ChannelTypeUID != nil {
+				channel.channelTypeUid = *c.ChannelTypeUID
+			}
+			if c.ItemType != nil {
+				channel.itemType = *c.ItemType
+			}
+			if c.Kind != nil {
+				channel.kind = *c.Kind
+			}
+			found = true
+			break
+		}
+	}
+	if !found || channel.channelTypeUid == "" || (channel.itemType != "" && channel.kind != "") {
+		return channel, found, nil
+	}
+
+	apiResp, err = client.GetChannelTypeByUID(ctx, channel.channelTypeUid, &api.GetChannelTypeByUIDParams{})
+	if err != nil {
+		return channel, found, err
+	}
+	if apiResp.StatusCode == 404 {
+		return channel, found, nil
+	}
+	if apiResp.StatusCode != 200 {
+		return channel, found, api.ReadResponseError(apiResp)
+	}
+
+	channelType := &api.ChannelTypeDTO{}
+	if err := api.ReadResponseBody(apiResp, channelType); err != nil {
+		return channel, found, err
+	}
+
+	if channel.itemType == "" && channelType.ItemType != nil {
+		channel.itemType = *channelType.ItemType
+	}
+	if channel.kind == "" && channelType.Kind != nil {
+		channel.kind = *channelType.Kind
+	}
+
+	return channel, found, nil
+}
+
+// linkProfile returns the profile of the link configuration, known is false if the configuration is not known yet.
+func linkProfile(data linkResourceData) (profile string, known bool) {
+	if data.ConfigurationJson.Unknown || data.Configuration.Unknown {
+		return "", false
+	}
+
+	if !data.ConfigurationJson.Null {
+		configuration, err := util.JsonToInterfaceMap(data.ConfigurationJson)
+		if err != nil || configuration == nil {
+			return "", err == nil
+		}
+
+		profile, _ := (*configuration)["profile"].(string)
+		return profile, true
+	}
+
+	if data.Configuration.Null {
+		return "", true
+	}
+
+	v, ok := data.Configuration.Elems["profile"].(types.String)
+	if !ok || v.Null {
+		return "", true
+	}
+	if v.Unknown {
+		return "", false
+	}
+
+	return v.Value, true
+}
@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/chris922/terraform-provider-openhab/internal/api"
+	"github.com/chris922/terraform-provider-openhab/internal/provider/util"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// metadata namespace storing the unit of the state of Number items, available since openHAB 4.0
+const unitNamespace = "unit"
+
+// putItemMetadata adds the metadata of the given namespace to the item or overwrites it if it already exists.
+func putItemMetadata(ctx context.Context, client *api.Client, itemName string, namespace string,
+	value types.String, config types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	configMap, err := util.JsonToInterfaceMap(config)
+	if err != nil {
+		diags.AddError("Put Metadata Error",
+			fmt.Sprintf("Unable to convert config of metadata %s of item %s, got error: %s", namespace, itemName, err))
+		return diags
+	}
+
+	body := api.AddMetadataToItemJSONRequestBody{
+		Value:  util.TypeToString(value),
+		Config: configMap,
+	}
+	apiResp, err := client.AddMetadataToItem(ctx, itemName, namespace, body)
+	if err != nil {
+		diags.AddError("Put Metadata Error",
+			fmt.Sprintf("Unable to put metadata %s of item %s, got error: %s", namespace, itemName, err))
+		return diags
+	}
+
+	if apiResp.StatusCode == 404 {
+		diags.AddError("Put Metadata Error",
+			fmt.Sprintf("Unable to put metadata %s, item %s does not exist", namespace, itemName))
+	} else if apiResp.StatusCode == 405 {
+		diags.AddError("Put Metadata Error",
+			fmt.Sprintf("Unable to put metadata %s, metadata of item %s is not editable", namespace, itemName))
+	} else if apiResp.StatusCode != 200 && apiResp.StatusCode != 201 {
+		diags.AddError("Put Metadata Error",
+			fmt.Sprintf("Unable to put metadata %s of item %s, got error: %s", namespace, itemName, api.ReadResponseError(apiResp)))
+	}
+
+	return diags
+}
+
+// removeItemMetadata removes the metadata of the given namespace from the item, missing metadata is ignored.
+func removeItemMetadata(ctx context.Context, client *api.Client, itemName string, namespace string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	apiResp, err := client.RemoveMetadataFromItem(ctx, itemName, namespace)
+	if err != nil {
+		diags.AddError("Remove Metadata Error",
+			fmt.Sprintf("Unable to remove metadata %s of item %s, got error: %s", namespace, itemName, err))
+		return diags
+	}
+
+	if apiResp.StatusCode == 404 {
+		tflog.Debug(ctx, "Planned to remove metadata, but it was already removed",
+			map[string]interface{}{"item_name": itemName, "namespace": namespace})
+	} else if apiResp.StatusCode != 200 {
+		diags.AddError("Remove Metadata Error",
+			fmt.Sprintf("Unable to remove metadata %s of item %s, got error: %s", namespace, itemName, api.ReadResponseError(apiResp)))
+	}
+
+	return diags
+}
+
+// enrichedMetadataToData extracts value and config of a single namespace out of the metadata of an enriched item.
+// The config is compared semantically to the prior config, see util.InterfaceMapToJson.
+func enrichedMetadataToData(metadata *api.EnrichedItemDTO_Metadata, namespace string,
+	priorConfig types.String) (value types.String, config types.String, found bool, err error) {
+	if metadata == nil {
+		return types.String{Null: true}, types.String{Null: true}, false, nil
+	}
+
+	namespaceMetadata, found := metadata.Get(namespace)
+	if !found {
+		return types.String{Null: true}, types.String{Null: true}, false, nil
+	}
+
+	if v, ok := namespaceMetadata["value"].(string); ok {
+		value = types.String{Value: v}
+	} else {
+		value = types.String{Null: true}
+	}
+
+	var configMap *map[string]interface{}
+	if v, ok := namespaceMetadata["config"].(map[string]interface{}); ok {
+		configMap = &v
+	}
+	config, err = util.InterfaceMapToJson(configMap, priorConfig)
+
+	return value, config, true, err
+}
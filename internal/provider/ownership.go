@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/chris922/terraform-provider-openhab/internal/api"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// metadata namespace marking items managed by the provider, its value is the workspace the item belongs to
+const ownershipNamespace = "terraform"
+
+// workspace used if none is configured
+const defaultWorkspaceId = "default"
+
+// ownership configures how items of other workspaces or created by hand are treated. A zero ownership, e.g. of a
+// resource created by an unconfigured provider, neither checks nor records owners.
+type ownership struct {
+	workspaceId string
+	// adoptExisting allows to take over existing items that are not owned by the workspace when creating an item
+	adoptExisting bool
+}
+
+// adopt returns whether an existing item may be taken over, the option of the resource overrides the provider.
+func (o ownership) adopt(resourceOption types.Bool) bool {
+	if resourceOption.Null || resourceOption.Unknown {
+		return o.adoptExisting
+	}
+
+	return resourceOption.Value
+}
+
+// getItemOwner returns the workspace owning the given item. Found is false if the item does not exist, the owner is
+// empty if the item is not marked, e.g. because it was created by hand.
+func getItemOwner(ctx context.Context, client *api.Client, itemName string) (owner string, found bool, diags diag.Diagnostics) {
+	namespace := ownershipNamespace
+	apiResp, err := client.GetItemByName(ctx, itemName, &api.GetItemByNameParams{Metadata: &namespace})
+	if err != nil {
+		diags.AddError("Read Item Owner Error",
+			fmt.Sprintf("Unable to read owner of item %s, got error: %s", itemName, err))
+		return "", false, diags
+	}
+
+	if apiResp.StatusCode == 404 {
+		return "", false, diags
+	}
+	if apiResp.StatusCode != 200 {
+		diags.AddError("Read Item Owner Error",
+			fmt.Sprintf("Unable to read owner of item %s, got error: %s", itemName, api.ReadResponseError(apiResp)))
+		return "", false, diags
+	}
+
+	apiRespObj := &api.EnrichedItemDTO{}
+	err = api.ReadResponseBody(apiResp, apiRespObj)
+	if err != nil {
+		diags.AddError("Read Item Owner Error",
+			fmt.Sprintf("Unable to read response of reading owner of item %s, got error: %s", itemName, err))
+		return "", false, diags
+	}
+
+	value, _, _, err := enrichedMetadataToData(apiRespObj.Metadata, ownershipNamespace, types.String{Null: true})
+	if err != nil || value.Null {
+		return "", true, diags
+	}
+
+	return value.Value, true, diags
+}
+
+// checkItemCreatable fails if the item already exists and must not be adopted by the workspace. An adopted item of
+// someone else is reported as warning, so taking it over does not go unnoticed.
+func (o ownership) checkItemCreatable(ctx context.Context, client *api.Client, itemName string, adopt bool) diag.Diagnostics {
+	if o.workspaceId == "" {
+		return nil
+	}
+
+	owner, found, diags := getItemOwner(ctx, client, itemName)
+	if diags.HasError() || !found || owner == o.workspaceId {
+		return diags
+	}
+
+	if adopt {
+		if owner == "" {
+			diags.AddWarning("Item Adopted",
+				fmt.Sprintf("Item %s already exists and is not managed by Terraform, it is taken over by the "+
+					"workspace %s.", itemName, o.workspaceId))
+		} else {
+			diags.AddWarning("Item Adopted",
+				fmt.Sprintf("Item %s already exists and is managed by the workspace %s, it is taken over by the "+
+					"workspace %s.", itemName, owner, o.workspaceId))
+		}
+	} else if owner == "" {
+		diags.AddError("Item Already Exists",
+			fmt.Sprintf("Item %s already exists and is not managed by Terraform. Import it or set adopt_existing "+
+				"to take it over.", itemName))
+	} else {
+		diags.AddError("Item Already Exists",
+			fmt.Sprintf("Item %s already exists and is managed by the workspace %s. Choose another name or set "+
+				"adopt_existing to take it over.", itemName, owner))
+	}
+
+	return diags
+}
+
+// checkItemDeletable fails if the item is owned by another workspace. Items without owner were created before the
+// ownership was recorded and can be deleted.
+func (o ownership) checkItemDeletable(ctx context.Context, client *api.Client, itemName string) diag.Diagnostics {
+	if o.workspaceId == "" {
+		return nil
+	}
+
+	owner, found, diags := getItemOwner(ctx, client, itemName)
+	if diags.HasError() || !found || owner == "" || owner == o.workspaceId {
+		return diags
+	}
+
+	diags.AddError("Item Owned By Another Workspace",
+		fmt.Sprintf("Item %s is managed by the workspace %s, it is not deleted by the workspace %s. Remove it from "+
+			"the state instead.", itemName, owner, o.workspaceId))
+
+	return diags
+}
+
+// markItemOwner records the workspace as owner of the item.
+func (o ownership) markItemOwner(ctx context.Context, client *api.Client, itemName string) diag.Diagnostics {
+	if o.workspaceId == "" {
+		return nil
+	}
+
+	return putItemMetadata(ctx, client, itemName, ownershipNamespace, types.String{Value: o.workspaceId},
+		types.String{Null: true})
+}
@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestItemCreateRefusesUnownedItem(t *testing.T) {
+	ctx := context.Background()
+
+	schema, diags := ItemResourceType{}.GetSchema(ctx)
+	if diags.HasError() {
+		t.Fatalf("unable to get schema: %v", diags)
+	}
+
+	tests := map[string]struct {
+		body          string
+		adopt         types.Bool
+		expectError   bool
+		expectWarning bool
+	}{
+		"item created by hand": {
+			body:        `{"name": "test_item", "type": "Number"}`,
+			adopt:       types.Bool{Null: true},
+			expectError: true,
+		},
+		"item of another workspace": {
+			body:        `{"name": "test_item", "type": "Number", "metadata": {"terraform": {"value": "other"}}}`,
+			adopt:       types.Bool{Null: true},
+			expectError: true,
+		},
+		"item of the same workspace": {
+			body:        `{"name": "test_item", "type": "Number", "metadata": {"terraform": {"value": "home"}}}`,
+			adopt:       types.Bool{Null: true},
+			expectError: false,
+		},
+		"adopted item": {
+			body:          `{"name": "test_item", "type": "Number"}`,
+			adopt:         types.Bool{Value: true},
+			expectError:   false,
+			expectWarning: true,
+		},
+		"adopted item of the same workspace": {
+			body:        `{"name": "test_item", "type": "Number", "metadata": {"terraform": {"value": "home"}}}`,
+			adopt:       types.Bool{Value: true},
+			expectError: false,
+		},
+	}
+
+	for name, test := range tests {
+		r := itemResource{itemRegistry: itemRegistry{
+			client:    testServer(t, "/items/test_item", http.StatusOK, test.body),
+			ownership: ownership{workspaceId: "home"},
+		}}
+
+		data := testItemData()
+		data.AdoptExisting = test.adopt
+		config := testState(t, schema, &data)
+
+		diags := r.ownership.checkItemCreatable(ctx, r.client, data.Name.Value, r.ownership.adopt(data.AdoptExisting))
+		if diags.HasError() != test.expectError {
+			t.Errorf("%s: expected error to be %t, got %v", name, test.expectError, diags)
+		}
+		if warned := !diags.HasError() && len(diags) > 0; warned != test.expectWarning {
+			t.Errorf("%s: expected warning to be %t, got %v", name, test.expectWarning, diags)
+		} else if warned && !strings.Contains(diags[0].Detail(), "test_item") {
+			t.Errorf("%s: expected warning to name the item, got %q", name, diags[0].Detail())
+		}
+
+		if test.expectError {
+			req := tfsdk.CreateResourceRequest{Config: tfsdk.Config{Schema: schema, Raw: config.Raw}}
+			resp := &tfsdk.CreateResourceResponse{State: tfsdk.State{Schema: schema}}
+			r.Create(ctx, req, resp)
+
+			if !resp.Diagnostics.HasError() {
+				t.Errorf("%s: expected create to fail", name)
+			}
+		}
+	}
+}
+
+func TestItemDeleteRefusesItemOfAnotherWorkspace(t *testing.T) {
+	ctx := context.Background()
+
+	schema, diags := ItemResourceType{}.GetSchema(ctx)
+	if diags.HasError() {
+		t.Fatalf("unable to get schema: %v", diags)
+	}
+
+	r := itemResource{itemRegistry: itemRegistry{
+		client: testServer(t, "/items/test_item", http.StatusOK,
+			`{"name": "test_item", "type": "Number", "metadata": {"terraform": {"value": "other"}}}`),
+		ownership: ownership{workspaceId: "home"},
+	}}
+
+	data := testItemData()
+	req := tfsdk.DeleteResourceRequest{State: testState(t, schema, &data)}
+	resp := &tfsdk.DeleteResourceResponse{State: req.State}
+	r.Delete(ctx, req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Errorf("expected item of another workspace to be kept")
+	}
+	if resp.State.Raw.IsNull() {
+		t.Errorf("expected item to be kept in state")
+	}
+}
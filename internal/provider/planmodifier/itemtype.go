@@ -0,0 +1,49 @@
+package planmodifier
+
+import (
+	"context"
+	"github.com/chris922/terraform-provider-openhab/internal/provider/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type itemTypeRequiresReplace struct {
+	tfsdk.AttributePlanModifier
+}
+
+// ItemTypeRequiresReplace replaces an item if its base type changes, e.g. from `Switch` to `Dimmer`. openHAB keeps
+// links, state and metadata of an item whose type is changed, which do not fit the new type. Changing only the
+// dimension of a `Number` item, e.g. from `Number` to `Number:Temperature`, updates the item in place.
+func ItemTypeRequiresReplace() *itemTypeRequiresReplace {
+	return &itemTypeRequiresReplace{}
+}
+
+func (m itemTypeRequiresReplace) Description(ctx context.Context) string {
+	return "If the base type of the item changes, Terraform will destroy and recreate the resource."
+}
+
+func (m itemTypeRequiresReplace) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m itemTypeRequiresReplace) Modify(_ context.Context, request tfsdk.ModifyAttributePlanRequest, response *tfsdk.ModifyAttributePlanResponse) {
+	// nothing to replace on create or destroy
+	if request.State.Raw.IsNull() || request.Plan.Raw.IsNull() {
+		return
+	}
+
+	state, ok := request.AttributeState.(types.String)
+	if !ok || state.Null || state.Unknown {
+		return
+	}
+	plan, ok := response.AttributePlan.(types.String)
+	if !ok || plan.Null || plan.Unknown {
+		return
+	}
+
+	stateBaseType, _ := validator.SplitItemType(state.Value)
+	planBaseType, _ := validator.SplitItemType(plan.Value)
+	if stateBaseType != planBaseType {
+		response.RequiresReplace = true
+	}
+}
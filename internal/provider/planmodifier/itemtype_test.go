@@ -0,0 +1,47 @@
+package planmodifier
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestItemTypeRequiresReplace(t *testing.T) {
+	ctx := context.Background()
+
+	// only the nullness of the resource matters, the attributes are passed separately
+	resource := tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{})
+
+	tests := []struct {
+		state           string
+		plan            string
+		requiresReplace bool
+	}{
+		{state: "Number", plan: "Number:Temperature", requiresReplace: false},
+		{state: "Number:Temperature", plan: "Number:Power", requiresReplace: false},
+		{state: "Number:Power", plan: "Number", requiresReplace: false},
+		{state: "Switch", plan: "Dimmer", requiresReplace: true},
+		{state: "Number:Temperature", plan: "String", requiresReplace: true},
+		{state: "Switch", plan: "Switch", requiresReplace: false},
+	}
+
+	for _, test := range tests {
+		request := tfsdk.ModifyAttributePlanRequest{
+			AttributePath:  tftypes.NewAttributePath().WithAttributeName("type"),
+			State:          tfsdk.State{Raw: resource},
+			Plan:           tfsdk.Plan{Raw: resource},
+			AttributeState: types.String{Value: test.state},
+			AttributePlan:  types.String{Value: test.plan},
+		}
+		response := &tfsdk.ModifyAttributePlanResponse{AttributePlan: request.AttributePlan}
+		ItemTypeRequiresReplace().Modify(ctx, request, response)
+
+		if response.RequiresReplace != test.requiresReplace {
+			t.Errorf("changing type from %s to %s: expected replace to be %t, got %t", test.state, test.plan,
+				test.requiresReplace, response.RequiresReplace)
+		}
+	}
+}
@@ -4,10 +4,14 @@ import (
 	"context"
 	"fmt"
 	"github.com/chris922/terraform-provider-openhab/internal/api"
+	"github.com/chris922/terraform-provider-openhab/internal/provider/util"
+	"github.com/chris922/terraform-provider-openhab/internal/provider/validator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"net/http"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"os"
 )
 
 // OpenhabProvider satisfies the tfsdk.Provider interface and usually is included
@@ -33,14 +37,76 @@ type OpenhabProvider struct {
 	data providerData
 
 	Client *api.Client
+
+	// serverVersion is the openHAB version of the configured server, used to check if features are available
+	serverVersion *serverVersion
+
+	// safeguard is shared by all resources to detect a misconfigured endpoint
+	safeguard *safeguard
+
+	itemDefaults itemDefaults
+	ownership    ownership
 }
 
 // providerData can be used to store data from the Terraform configuration.
 type providerData struct {
 	Endpoint types.String `tfsdk:"endpoint"`
 	ApiToken types.String `tfsdk:"api_token"`
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+
+	CaCertFile         types.String `tfsdk:"ca_cert_file"`
+	CaCertPem          types.String `tfsdk:"ca_cert_pem"`
+	ClientCertFile     types.String `tfsdk:"client_cert_file"`
+	ClientCertPem      types.String `tfsdk:"client_cert_pem"`
+	ClientKeyFile      types.String `tfsdk:"client_key_file"`
+	ClientKeyPem       types.String `tfsdk:"client_key_pem"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+
+	MaxRetries      types.Int64   `tfsdk:"max_retries"`
+	RetryBackoff    types.String  `tfsdk:"retry_backoff"`
+	RetryMaxBackoff types.String  `tfsdk:"retry_max_backoff"`
+	RetryJitter     types.Float64 `tfsdk:"retry_jitter"`
+	ReadyTimeout    types.String  `tfsdk:"ready_timeout"`
+
+	MaxMissingFraction types.Float64 `tfsdk:"max_missing_fraction"`
+
+	DefaultTags       types.List `tfsdk:"default_tags"`
+	DefaultGroupNames types.List `tfsdk:"default_group_names"`
+
+	WorkspaceId        types.String `tfsdk:"workspace_id"`
+	AdoptExistingItems types.Bool   `tfsdk:"adopt_existing_items"`
+}
+
+// itemDefaults are tags and groups added to every item managed by the provider.
+type itemDefaults struct {
+	tags       []string
+	groupNames []string
 }
 
+// groupNamesOf returns the default groups of the given item. A default group managed as an item itself is not added
+// to its own groups, openHAB would reject it or the group would contain itself.
+func (d itemDefaults) groupNamesOf(itemName string) []string {
+	var groupNames []string
+	for _, groupName := range d.groupNames {
+		if groupName != itemName {
+			groupNames = append(groupNames, groupName)
+		}
+	}
+
+	return groupNames
+}
+
+// environment variables used if the related attribute is not set in the provider configuration
+const (
+	envEndpoint = "OPENHAB_ENDPOINT"
+	envApiToken = "OPENHAB_API_TOKEN"
+	envUsername = "OPENHAB_USERNAME"
+	envPassword = "OPENHAB_PASSWORD"
+
+	envWorkspaceId = "OPENHAB_WORKSPACE_ID"
+)
+
 func (p *OpenhabProvider) Configure(ctx context.Context, req tfsdk.ConfigureProviderRequest, resp *tfsdk.ConfigureProviderResponse) {
 	var data providerData
 	diags := req.Config.Get(ctx, &data)
@@ -50,30 +116,175 @@ func (p *OpenhabProvider) Configure(ctx context.Context, req tfsdk.ConfigureProv
 		return
 	}
 
-	// Configuration values are now available.
-	// if data.Example.Null { /* ... */ }
+	// attributes that are not configured fall back to the environment, e.g. to avoid secrets in HCL
+	data.Endpoint = stringFromEnv(data.Endpoint, envEndpoint)
+	data.ApiToken = stringFromEnv(data.ApiToken, envApiToken)
+	data.Username = stringFromEnv(data.Username, envUsername)
+	data.Password = stringFromEnv(data.Password, envPassword)
+	data.WorkspaceId = stringFromEnv(data.WorkspaceId, envWorkspaceId)
 
-	// If the upstream OpenhabProvider SDK or HTTP client requires configuration, such
-	// as authentication or logging, this is a great opportunity to do so.
-	client, err := api.NewClient(data.Endpoint.Value, api.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
-		req.SetBasicAuth(data.ApiToken.Value, "")
-		return nil
-	}))
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error",
-			fmt.Sprintf("Unable to create client, got error: %s", err))
+	diags = validateProviderData(data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	client, info, diags := verifyClient(ctx, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Connected to openHAB server", map[string]interface{}{"version": info.version.String()})
+
+	maxMissingFraction := 0.5
+	if !data.MaxMissingFraction.Null {
+		maxMissingFraction = data.MaxMissingFraction.Value
+	}
+
+	p.data = data
 	p.Client = client
+	p.serverVersion = info.version
+	p.safeguard = newSafeguard(client, info.uuid, maxMissingFraction)
+	p.itemDefaults = itemDefaults{
+		tags:       stringArrayOrEmpty(data.DefaultTags),
+		groupNames: stringArrayOrEmpty(data.DefaultGroupNames),
+	}
+	p.ownership = ownership{
+		workspaceId: defaultWorkspaceId,
+	}
+	if !data.WorkspaceId.Null {
+		p.ownership.workspaceId = data.WorkspaceId.Value
+	}
+	if !data.AdoptExistingItems.Null {
+		p.ownership.adoptExisting = data.AdoptExistingItems.Value
+	}
 	p.Configured = true
 }
 
+// stringFromEnv returns the given value or, if it is not configured, the value of the given environment variable.
+func stringFromEnv(v types.String, key string) types.String {
+	if !v.Null {
+		return v
+	}
+
+	if value := os.Getenv(key); value != "" {
+		return types.String{Value: value}
+	}
+
+	return v
+}
+
+// validateProviderData checks the provider configuration including the values taken from the environment, these are
+// not covered by the attribute validators.
+func validateProviderData(data providerData) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, attribute := range []struct {
+		name  string
+		value types.String
+	}{
+		{"endpoint", data.Endpoint},
+		{"api_token", data.ApiToken},
+		{"username", data.Username},
+		{"password", data.Password},
+		{"workspace_id", data.WorkspaceId},
+	} {
+		if attribute.value.Unknown {
+			diags.AddAttributeError(tftypes.NewAttributePath().WithAttributeName(attribute.name),
+				"Unknown Provider Configuration",
+				fmt.Sprintf("The %s has to be known when configuring the provider.", attribute.name))
+		}
+	}
+	for _, attribute := range []struct {
+		name  string
+		value types.List
+	}{
+		{"default_tags", data.DefaultTags},
+		{"default_group_names", data.DefaultGroupNames},
+	} {
+		if attribute.value.Unknown {
+			diags.AddAttributeError(tftypes.NewAttributePath().WithAttributeName(attribute.name),
+				"Unknown Provider Configuration",
+				fmt.Sprintf("The %s have to be known when configuring the provider.", attribute.name))
+		}
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	if !data.WorkspaceId.Null && data.WorkspaceId.Value == "" {
+		diags.AddAttributeError(tftypes.NewAttributePath().WithAttributeName("workspace_id"),
+			"Invalid Workspace ID", "The workspace ID must not be empty.")
+	}
+
+	if data.Endpoint.Null {
+		diags.AddAttributeError(tftypes.NewAttributePath().WithAttributeName("endpoint"),
+			"Missing Endpoint",
+			fmt.Sprintf("The endpoint has to be configured, either using the endpoint attribute or the %s "+
+				"environment variable.", envEndpoint))
+	} else if err := validator.ValidateEndpoint(data.Endpoint.Value); err != nil {
+		diags.AddAttributeError(tftypes.NewAttributePath().WithAttributeName("endpoint"), "Invalid endpoint", err.Error())
+	}
+
+	if !data.ApiToken.Null {
+		if !data.Username.Null || !data.Password.Null {
+			diags.AddAttributeError(tftypes.NewAttributePath().WithAttributeName("api_token"),
+				"Conflicting Credentials", "Either an API token or username and password can be used, not both.")
+		} else if err := validator.ValidateApiToken(data.ApiToken.Value); err != nil {
+			diags.AddAttributeError(tftypes.NewAttributePath().WithAttributeName("api_token"), "Invalid API token", err.Error())
+		}
+	} else if data.Username.Null || data.Password.Null {
+		diags.AddError("Missing Credentials",
+			fmt.Sprintf("Either an API token (api_token or %s) or username and password (username and password or "+
+				"%s and %s) have to be configured.", envApiToken, envUsername, envPassword))
+	}
+
+	if !data.CaCertFile.Null && !data.CaCertPem.Null {
+		diags.AddAttributeError(tftypes.NewAttributePath().WithAttributeName("ca_cert_pem"),
+			"Conflicting CA Certificates", "Only one of ca_cert_file and ca_cert_pem can be set.")
+	}
+	if !data.ClientCertFile.Null && !data.ClientCertPem.Null {
+		diags.AddAttributeError(tftypes.NewAttributePath().WithAttributeName("client_cert_pem"),
+			"Conflicting Client Certificates", "Only one of client_cert_file and client_cert_pem can be set.")
+	}
+	if !data.ClientKeyFile.Null && !data.ClientKeyPem.Null {
+		diags.AddAttributeError(tftypes.NewAttributePath().WithAttributeName("client_key_pem"),
+			"Conflicting Client Keys", "Only one of client_key_file and client_key_pem can be set.")
+	}
+	if (data.ClientCertFile.Null && data.ClientCertPem.Null) != (data.ClientKeyFile.Null && data.ClientKeyPem.Null) {
+		diags.AddError("Incomplete Client Certificate",
+			"A client certificate requires a client key and vice versa.")
+	}
+
+	if !data.MaxRetries.Null && data.MaxRetries.Value < 0 {
+		diags.AddAttributeError(tftypes.NewAttributePath().WithAttributeName("max_retries"),
+			"Invalid Max Retries", "The number of retries must not be negative.")
+	}
+	if !data.RetryJitter.Null && (data.RetryJitter.Value < 0 || data.RetryJitter.Value > 1) {
+		diags.AddAttributeError(tftypes.NewAttributePath().WithAttributeName("retry_jitter"),
+			"Invalid Retry Jitter", "The jitter has to be a fraction between 0 and 1.")
+	}
+	if !data.MaxMissingFraction.Null && (data.MaxMissingFraction.Value < 0 || data.MaxMissingFraction.Value > 1) {
+		diags.AddAttributeError(tftypes.NewAttributePath().WithAttributeName("max_missing_fraction"),
+			"Invalid Max Missing Fraction", "The fraction of missing resources has to be between 0 and 1.")
+	}
+
+	return diags
+}
+
 func (p *OpenhabProvider) GetResources(ctx context.Context) (map[string]tfsdk.ResourceType, diag.Diagnostics) {
 	return map[string]tfsdk.ResourceType{
 		//"scaffolding_example": ExampleResourceType{},
-		"openhab_item": ItemResourceType{},
-		"openhab_link": LinkResourceType{},
+		"openhab_equipment":     EquipmentResourceType{},
+		"openhab_item":          ItemResourceType{},
+		"openhab_item_metadata": ItemMetadataResourceType{},
+		"openhab_link":          LinkResourceType{},
+		"openhab_location":      LocationResourceType{},
+		"openhab_rule":          RuleResourceType{},
+		"openhab_thing":         ThingResourceType{},
 	}, nil
 }
 
@@ -86,17 +297,160 @@ func (p *OpenhabProvider) GetDataSources(ctx context.Context) (map[string]tfsdk.
 func (p *OpenhabProvider) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
 	return tfsdk.Schema{
 		Attributes: map[string]tfsdk.Attribute{
+			"adopt_existing_items": {
+				MarkdownDescription: "Take over existing items with the same name when creating an item, even if they " +
+					"are not managed by the workspace. Otherwise creating the item fails, so items created by hand or " +
+					"by another workspace are not overwritten. Adopted items are reported as warning. Defaults to " +
+					"`false`.",
+				Optional: true,
+				Type:     types.BoolType,
+			},
+			"default_group_names": {
+				MarkdownDescription: "Groups every item managed by the provider is added to, in addition to the " +
+					"`group_names` of the item. They are not shown as `group_names` of the items, unless also configured " +
+					"there. A default group managed as an item is not added to itself.",
+				Optional: true,
+				Type:     types.ListType{ElemType: types.StringType},
+			},
+			"default_tags": {
+				MarkdownDescription: "Tags added to every item managed by the provider, e.g. `terraform`, in addition " +
+					"to the `tags` of the item. They are not shown as `tags` of the items, unless also configured there.",
+				Optional: true,
+				Type:     types.ListType{ElemType: types.StringType},
+			},
 			"endpoint": {
-				MarkdownDescription: "API endpoint of the target openHAB server, usually the URL with `/rest` suffix, e.g. `http://openhab:8080/rest`",
-				Required:            true,
-				Type:                types.StringType,
-				// TODO: validator
+				MarkdownDescription: "API endpoint of the target openHAB server, usually the URL with `/rest` suffix, " +
+					"e.g. `http://openhab:8080/rest`, the suffix is added if missing. The connection and " +
+					"credentials are verified when configuring the provider. Can also be set using the " +
+					"`OPENHAB_ENDPOINT` environment variable.",
+				Optional: true,
+				Type:     types.StringType,
+				Validators: []tfsdk.AttributeValidator{
+					validator.EndpointValidator(),
+				},
 			},
 			"api_token": {
-				MarkdownDescription: "API token used to authenticate against the openHAB server",
-				Required:            true,
-				Type:                types.StringType,
-				// TODO: validator
+				MarkdownDescription: "API token used to authenticate against the openHAB server, it is sent as " +
+					"bearer token. Can also be set using the `OPENHAB_API_TOKEN` environment variable. Conflicts " +
+					"with `username` and `password`.",
+				Optional:  true,
+				Sensitive: true,
+				Type:      types.StringType,
+				Validators: []tfsdk.AttributeValidator{
+					validator.ApiTokenValidator(),
+				},
+			},
+			"workspace_id": {
+				MarkdownDescription: "Identifier of the workspace, recorded as owner in the `terraform` metadata " +
+					"namespace of every created item. Items owned by another workspace are not deleted. Can also be " +
+					"set using the `OPENHAB_WORKSPACE_ID` environment variable. Defaults to `default`.",
+				Optional: true,
+				Type:     types.StringType,
+			},
+			"username": {
+				MarkdownDescription: "Username used for basic authentication, e.g. if API tokens are disabled. " +
+					"Can also be set using the `OPENHAB_USERNAME` environment variable.",
+				Optional: true,
+				Type:     types.StringType,
+			},
+			"password": {
+				MarkdownDescription: "Password used for basic authentication. Can also be set using the " +
+					"`OPENHAB_PASSWORD` environment variable.",
+				Optional:  true,
+				Sensitive: true,
+				Type:      types.StringType,
+			},
+			"ca_cert_file": {
+				MarkdownDescription: "Path to a PEM encoded CA bundle used to verify the server certificate, e.g. " +
+					"of a reverse proxy using an internal CA. Conflicts with `ca_cert_pem`.",
+				Optional: true,
+				Type:     types.StringType,
+			},
+			"ca_cert_pem": {
+				MarkdownDescription: "PEM encoded CA bundle used to verify the server certificate. Conflicts " +
+					"with `ca_cert_file`.",
+				Optional: true,
+				Type:     types.StringType,
+			},
+			"client_cert_file": {
+				MarkdownDescription: "Path to a PEM encoded client certificate used for mutual TLS, requires " +
+					"`client_key_file` or `client_key_pem`. Conflicts with `client_cert_pem`.",
+				Optional: true,
+				Type:     types.StringType,
+			},
+			"client_cert_pem": {
+				MarkdownDescription: "PEM encoded client certificate used for mutual TLS, requires " +
+					"`client_key_file` or `client_key_pem`. Conflicts with `client_cert_file`.",
+				Optional: true,
+				Type:     types.StringType,
+			},
+			"client_key_file": {
+				MarkdownDescription: "Path to the PEM encoded private key of the client certificate. Conflicts " +
+					"with `client_key_pem`.",
+				Optional: true,
+				Type:     types.StringType,
+			},
+			"client_key_pem": {
+				MarkdownDescription: "PEM encoded private key of the client certificate. Conflicts with " +
+					"`client_key_file`.",
+				Optional:  true,
+				Sensitive: true,
+				Type:      types.StringType,
+			},
+			"insecure_skip_verify": {
+				MarkdownDescription: "Skip the verification of the server certificate, only use this for testing. " +
+					"Defaults to `false`.",
+				Optional: true,
+				Type:     types.BoolType,
+			},
+			"max_retries": {
+				MarkdownDescription: "Number of retries of requests that failed temporarily, e.g. with status 503 " +
+					"while openHAB is starting. Defaults to `5`, `0` disables retries.",
+				Optional: true,
+				Type:     types.Int64Type,
+			},
+			"retry_backoff": {
+				MarkdownDescription: "Wait time before the first retry, it is doubled for every further retry. " +
+					"Defaults to `1s`.",
+				Optional: true,
+				Type:     types.StringType,
+				Validators: []tfsdk.AttributeValidator{
+					validator.DurationValidator(),
+				},
+			},
+			"retry_max_backoff": {
+				MarkdownDescription: "Maximum wait time between two retries, also limits the wait time requested by a `Retry-After` " +
+					"header. Defaults to `30s`.",
+				Optional: true,
+				Type:     types.StringType,
+				Validators: []tfsdk.AttributeValidator{
+					validator.DurationValidator(),
+				},
+			},
+			"retry_jitter": {
+				MarkdownDescription: "Fraction of the wait time between two retries that is randomized, e.g. `0.2` " +
+					"for +/- 20%. Defaults to `0.2`.",
+				Optional: true,
+				Type:     types.Float64Type,
+			},
+			"max_missing_fraction": {
+				MarkdownDescription: "Maximum fraction of items and links that may be missing when refreshing the " +
+					"state, evaluated once at least 10 of a kind were read. If more are missing, an error is raised " +
+					"instead of removing them from state, because the endpoint probably points to the wrong server. " +
+					"Before that, missing resources are only kept if none of their kind was found and the server has " +
+					"no items at all. Defaults to `0.5`, `1` disables the check.",
+				Optional: true,
+				Type:     types.Float64Type,
+			},
+			"ready_timeout": {
+				MarkdownDescription: "Maximum time to wait for openHAB to finish starting before a missing resource " +
+					"is treated as deleted. openHAB answers with 404 while it is starting. Defaults to `5m`, `0s` " +
+					"disables the check.",
+				Optional: true,
+				Type:     types.StringType,
+				Validators: []tfsdk.AttributeValidator{
+					validator.DurationValidator(),
+				},
 			},
 		},
 	}, nil
@@ -138,3 +492,12 @@ func ConvertProviderType(in tfsdk.Provider) (OpenhabProvider, diag.Diagnostics)
 
 	return *p, diags
 }
+
+// stringArrayOrEmpty converts the given list, a null list results in an empty slice.
+func stringArrayOrEmpty(v types.List) []string {
+	if values := util.TypeToStringArray(v); values != nil {
+		return *values
+	}
+
+	return []string{}
+}
@@ -1,9 +1,16 @@
 package provider
 
 import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/chris922/terraform-provider-openhab/internal/api"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
 
@@ -22,3 +29,248 @@ func testAccPreCheck(t *testing.T) {
 	// about the appropriate environment variables being set are common to see in a pre-check
 	// function.
 }
+
+// testProviderData returns a provider configuration without any attributes set, besides the endpoint that is set
+// to the test server by testProviderConfigure. Retries and the readiness check are disabled to keep tests fast.
+func testProviderData() providerData {
+	return providerData{
+		Endpoint:           types.String{},
+		ApiToken:           types.String{Null: true},
+		Username:           types.String{Null: true},
+		Password:           types.String{Null: true},
+		CaCertFile:         types.String{Null: true},
+		CaCertPem:          types.String{Null: true},
+		ClientCertFile:     types.String{Null: true},
+		ClientCertPem:      types.String{Null: true},
+		ClientKeyFile:      types.String{Null: true},
+		ClientKeyPem:       types.String{Null: true},
+		InsecureSkipVerify: types.Bool{Null: true},
+		MaxRetries:         types.Int64{Value: 0},
+		RetryBackoff:       types.String{Null: true},
+		RetryMaxBackoff:    types.String{Null: true},
+		RetryJitter:        types.Float64{Null: true},
+		ReadyTimeout:       types.String{Value: "0s"},
+		MaxMissingFraction: types.Float64{Null: true},
+		DefaultTags:        types.List{ElemType: types.StringType, Null: true},
+		DefaultGroupNames:  types.List{ElemType: types.StringType, Null: true},
+		WorkspaceId:        types.String{Null: true},
+		AdoptExistingItems: types.Bool{Null: true},
+	}
+}
+
+// testOpenhabServer starts a server providing the REST root of openHAB at `/rest`, the UI is served at `/`.
+// Authenticated endpoints answer with the status of the sent authorization header, unknown headers are rejected. If
+// no statuses are given, every request with an authorization header is accepted. The last authorization header sent
+// is stored in the given string.
+func testOpenhabServer(t *testing.T, statuses map[string]int, authorization *string) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*authorization = r.Header.Get("Authorization")
+
+		switch r.URL.Path {
+		case "/":
+			w.Header().Set("Content-Type", "text/html")
+			_, _ = w.Write([]byte(`<html></html>`))
+		case "/rest/":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"version": "4", "links": [{"type": "items", "url": "/rest/items"}], ` +
+				`"runtimeInfo": {"version": "3.2.0", "buildString": "Release Build"}}`))
+		case "/rest/uuid", "/rest/links":
+			status, ok := statuses[*authorization]
+			if statuses == nil && *authorization != "" {
+				status, ok = http.StatusOK, true
+			}
+			if !ok {
+				status = http.StatusUnauthorized
+			}
+
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte(`[]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+// testProviderConfigure configures a provider using the given configuration and returns the authorization header
+// sent by its client.
+func testProviderConfigure(t *testing.T, data providerData) (string, diag.Diagnostics) {
+	ctx := context.Background()
+
+	var authorization string
+	server := testOpenhabServer(t, nil, &authorization)
+
+	if data.Endpoint.Value == "" && !data.Endpoint.Null {
+		data.Endpoint = types.String{Value: server.URL + "/rest"}
+	}
+
+	p := &OpenhabProvider{}
+	schema, diags := p.GetSchema(ctx)
+	if diags.HasError() {
+		t.Fatalf("unable to get schema: %v", diags)
+	}
+
+	req := tfsdk.ConfigureProviderRequest{Config: tfsdk.Config{Schema: schema, Raw: testState(t, schema, &data).Raw}}
+	resp := &tfsdk.ConfigureProviderResponse{}
+	p.Configure(ctx, req, resp)
+
+	if resp.Diagnostics.HasError() {
+		return "", resp.Diagnostics
+	}
+
+	apiResp, err := p.Client.GetItems(ctx, &api.GetItemsParams{})
+	if err != nil {
+		t.Fatalf("unable to send request: %s", err)
+	}
+	_ = apiResp.Body.Close()
+
+	return authorization, resp.Diagnostics
+}
+
+func TestProviderConfigureUsesBearerToken(t *testing.T) {
+	data := testProviderData()
+	data.ApiToken = types.String{Value: "oh.terraform.secret"}
+
+	authorization, diags := testProviderConfigure(t, data)
+	if diags.HasError() {
+		t.Fatalf("unexpected error configuring provider: %v", diags)
+	}
+
+	if authorization != "Bearer oh.terraform.secret" {
+		t.Errorf("expected API token to be sent as bearer token, got %q", authorization)
+	}
+}
+
+func TestProviderConfigureUsesBasicAuth(t *testing.T) {
+	data := testProviderData()
+	data.Username = types.String{Value: "admin"}
+	data.Password = types.String{Value: "secret"}
+
+	authorization, diags := testProviderConfigure(t, data)
+	if diags.HasError() {
+		t.Fatalf("unexpected error configuring provider: %v", diags)
+	}
+
+	expected := "Basic " + base64.StdEncoding.EncodeToString([]byte("admin:secret"))
+	if authorization != expected {
+		t.Errorf("expected username and password to be sent using basic auth, got %q", authorization)
+	}
+}
+
+func TestProviderConfigureFallsBackToEnvironment(t *testing.T) {
+	t.Setenv(envApiToken, "oh.terraform.env")
+
+	data := testProviderData()
+
+	authorization, diags := testProviderConfigure(t, data)
+	if diags.HasError() {
+		t.Fatalf("unexpected error configuring provider: %v", diags)
+	}
+
+	if authorization != "Bearer oh.terraform.env" {
+		t.Errorf("expected API token to be read from environment, got %q", authorization)
+	}
+}
+
+func TestProviderConfigureRejectsInvalidConfiguration(t *testing.T) {
+	t.Setenv(envEndpoint, "")
+	t.Setenv(envApiToken, "")
+	t.Setenv(envUsername, "")
+	t.Setenv(envPassword, "")
+
+	tests := map[string]func(data *providerData){
+		"missing endpoint": func(data *providerData) {
+			data.Endpoint = types.String{Null: true}
+			data.ApiToken = types.String{Value: "oh.terraform.secret"}
+		},
+		"missing credentials": func(data *providerData) {},
+		"conflicting credentials": func(data *providerData) {
+			data.ApiToken = types.String{Value: "oh.terraform.secret"}
+			data.Username = types.String{Value: "admin"}
+			data.Password = types.String{Value: "secret"}
+		},
+		"missing password": func(data *providerData) {
+			data.Username = types.String{Value: "admin"}
+		},
+		"client certificate without key": func(data *providerData) {
+			data.ApiToken = types.String{Value: "oh.terraform.secret"}
+			data.ClientCertPem = types.String{Value: "certificate"}
+		},
+	}
+
+	for name, modify := range tests {
+		data := testProviderData()
+		modify(&data)
+
+		if _, diags := testProviderConfigure(t, data); !diags.HasError() {
+			t.Errorf("expected %s to be rejected", name)
+		}
+	}
+}
+
+func TestProviderConfigureNormalizesEndpoint(t *testing.T) {
+	var authorization string
+	server := testOpenhabServer(t, nil, &authorization)
+
+	data := testProviderData()
+	data.Endpoint = types.String{Value: server.URL}
+	data.ApiToken = types.String{Value: "oh.terraform.secret"}
+
+	client, info, diags := verifyClient(context.Background(), data)
+	if diags.HasError() {
+		t.Fatalf("unexpected error verifying client: %v", diags)
+	}
+
+	if client.Server != server.URL+"/rest/" {
+		t.Errorf("expected endpoint to be normalized, got %s", client.Server)
+	}
+	if info.version.String() != "3.2.0" {
+		t.Errorf("expected server version to be detected, got %s", info.version)
+	}
+}
+
+func TestProviderConfigureReportsConnectionErrors(t *testing.T) {
+	var authorization string
+	server := testOpenhabServer(t, map[string]int{
+		"Bearer oh.terraform.admin": http.StatusOK,
+		"Bearer oh.terraform.user":  http.StatusForbidden,
+	}, &authorization)
+	notOpenhab := httptest.NewServer(http.NotFoundHandler())
+	t.Cleanup(notOpenhab.Close)
+	unreachable := httptest.NewServer(http.NotFoundHandler())
+	unreachable.Close()
+
+	tests := []struct {
+		endpoint string
+		apiToken string
+		summary  string
+	}{
+		{endpoint: unreachable.URL + "/rest", apiToken: "oh.terraform.admin", summary: "Unreachable openHAB Server"},
+		{endpoint: notOpenhab.URL + "/rest", apiToken: "oh.terraform.admin", summary: "Invalid openHAB Endpoint"},
+		{endpoint: server.URL + "/rest", apiToken: "oh.terraform.expired", summary: "Unauthorized"},
+		{endpoint: server.URL + "/rest", apiToken: "oh.terraform.user", summary: "Insufficient Permissions"},
+		{endpoint: server.URL + "/rest", apiToken: "oh.terraform.admin", summary: ""},
+	}
+
+	for _, test := range tests {
+		data := testProviderData()
+		data.Endpoint = types.String{Value: test.endpoint}
+		data.ApiToken = types.String{Value: test.apiToken}
+
+		_, _, diags := verifyClient(context.Background(), data)
+
+		summary := ""
+		for _, d := range diags {
+			if d.Severity() == diag.SeverityError {
+				summary = d.Summary()
+				break
+			}
+		}
+		if summary != test.summary {
+			t.Errorf("expected error %q for endpoint %s and token %q, got %v", test.summary, test.endpoint,
+				test.apiToken, diags)
+		}
+	}
+}
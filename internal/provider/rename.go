@@ -0,0 +1,390 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/chris922/terraform-provider-openhab/internal/api"
+	"github.com/chris922/terraform-provider-openhab/internal/provider/util"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"time"
+)
+
+// format of times accepted by the persistence endpoints of openHAB
+const persistenceTimeFormat = "2006-01-02T15:04:05.000-0700"
+
+// start of the history that is copied when renaming an item if no start time is configured, openHAB only returns the
+// last day by default
+const persistenceHistoryStart = "1970-01-01T00:00:00.000+0000"
+
+// number of persisted states read per request, so long histories are not returned in a single response
+const persistencePageLength = 1000
+
+// itemRename describes the move of an existing item to a new name.
+type itemRename struct {
+	from string
+	to   string
+	// namespaces managed by the resource, they are written by the resource itself and not copied
+	managedNamespaces map[string]itemMetadataData
+	copyPersistence   bool
+	// persistence service to copy the states of, the default service of openHAB is used if empty
+	persistenceServiceId string
+	// time of the oldest state copied in RFC 3339 format, the whole history is copied if empty
+	persistenceStartTime string
+}
+
+// readRenamedItem reads the item that is renamed including all its metadata. Found is false if the item does not
+// exist anymore, e.g. because an earlier rename already removed it.
+func readRenamedItem(ctx context.Context, client *api.Client, itemName string) (item *api.EnrichedItemDTO, found bool, diags diag.Diagnostics) {
+	allNamespaces := ".+"
+	apiResp, err := client.GetItemByName(ctx, itemName, &api.GetItemByNameParams{Metadata: &allNamespaces})
+	if err != nil {
+		diags.AddError("Rename Item Error",
+			fmt.Sprintf("Unable to read item %s, got error: %s", itemName, err))
+		return nil, false, diags
+	}
+
+	if apiResp.StatusCode == 404 {
+		return nil, false, diags
+	}
+	if apiResp.StatusCode != 200 {
+		diags.AddError("Rename Item Error",
+			fmt.Sprintf("Unable to read item %s, got error: %s", itemName, api.ReadResponseError(apiResp)))
+		return nil, false, diags
+	}
+
+	item = &api.EnrichedItemDTO{}
+	err = api.ReadResponseBody(apiResp, item)
+	if err != nil {
+		diags.AddError("Rename Item Error",
+			fmt.Sprintf("Unable to read response of reading item %s, got error: %s", itemName, err))
+		return nil, false, diags
+	}
+
+	return item, true, diags
+}
+
+// moveItem moves links, metadata, group members and optionally the persisted states of the old item to the new item,
+// which has to be created before. The old item is removed afterwards.
+func (rename itemRename) moveItem(ctx context.Context, client *api.Client, old *api.EnrichedItemDTO) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(rename.moveLinks(ctx, client)...)
+	diags.Append(rename.copyMetadata(ctx, client, old.Metadata)...)
+	diags.Append(rename.moveMembers(ctx, client)...)
+	if rename.copyPersistence {
+		diags.Append(rename.copyPersistedStates(ctx, client)...)
+	}
+
+	// keep the old item if anything could not be moved, so nothing is lost and the rename can be retried
+	if diags.HasError() {
+		return diags
+	}
+
+	apiResp, err := client.RemoveItemFromRegistry(ctx, rename.from)
+	if err != nil {
+		diags.AddError("Rename Item Error",
+			fmt.Sprintf("Unable to delete item %s, got error: %s", rename.from, err))
+		return diags
+	}
+
+	if apiResp.StatusCode == 404 {
+		tflog.Debug(ctx, "Planned to remove the renamed item, but it was already removed", map[string]interface{}{"name": rename.from})
+	} else if apiResp.StatusCode != 200 {
+		diags.AddError("Rename Item Error",
+			fmt.Sprintf("Unable to delete item %s, got error: %s", rename.from, api.ReadResponseError(apiResp)))
+	}
+
+	return diags
+}
+
+// moveLinks links the new item to all channels of the old item and removes the links of the old item. Channels that
+// are linked to the new item already, e.g. by an earlier rename that failed, are not linked again.
+func (rename itemRename) moveLinks(ctx context.Context, client *api.Client) diag.Diagnostics {
+	links, diags := readItemLinks(ctx, client, rename.from)
+	if diags.HasError() {
+		return diags
+	}
+
+	newLinks, diags := readItemLinks(ctx, client, rename.to)
+	if diags.HasError() {
+		return diags
+	}
+
+	linkedChannels := make([]string, 0, len(newLinks))
+	for _, link := range newLinks {
+		if link.ChannelUID != nil && link.ItemName != nil && *link.ItemName == rename.to {
+			linkedChannels = append(linkedChannels, *link.ChannelUID)
+		}
+	}
+
+	for _, link := range links {
+		if link.ChannelUID == nil || link.ItemName == nil || *link.ItemName != rename.from {
+			continue
+		}
+		channelUid := *link.ChannelUID
+
+		if !util.StringArrayContains(linkedChannels, channelUid) {
+			body := api.LinkItemToChannelJSONRequestBody{
+				ItemName:      &rename.to,
+				ChannelUID:    &channelUid,
+				Configuration: link.Configuration,
+			}
+			apiResp, err := client.LinkItemToChannel(ctx, rename.to, channelUid, body)
+			if err != nil {
+				diags.AddError("Rename Item Error",
+					fmt.Sprintf("Unable to link item %s to channel %s, got error: %s", rename.to, channelUid, err))
+				continue
+			}
+			if apiResp.StatusCode != 200 {
+				diags.AddError("Rename Item Error",
+					fmt.Sprintf("Unable to link item %s to channel %s, got error: %s", rename.to, channelUid, api.ReadResponseError(apiResp)))
+				continue
+			}
+		}
+
+		apiResp, err := client.UnlinkItemFromChannel(ctx, rename.from, channelUid)
+		if err != nil {
+			diags.AddError("Rename Item Error",
+				fmt.Sprintf("Unable to unlink item %s from channel %s, got error: %s", rename.from, channelUid, err))
+			continue
+		}
+		if apiResp.StatusCode != 200 && apiResp.StatusCode != 404 {
+			diags.AddError("Rename Item Error",
+				fmt.Sprintf("Unable to unlink item %s from channel %s, got error: %s", rename.from, channelUid, api.ReadResponseError(apiResp)))
+		}
+	}
+
+	return diags
+}
+
+// readItemLinks reads all links of the given item.
+func readItemLinks(ctx context.Context, client *api.Client, itemName string) ([]api.EnrichedItemChannelLinkDTO, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	apiResp, err := client.GetItemLinks(ctx, &api.GetItemLinksParams{ItemName: &itemName})
+	if err != nil {
+		diags.AddError("Rename Item Error",
+			fmt.Sprintf("Unable to read links of item %s, got error: %s", itemName, err))
+		return nil, diags
+	}
+	if apiResp.StatusCode != 200 {
+		diags.AddError("Rename Item Error",
+			fmt.Sprintf("Unable to read links of item %s, got error: %s", itemName, api.ReadResponseError(apiResp)))
+		return nil, diags
+	}
+
+	var links []api.EnrichedItemChannelLinkDTO
+	if err := api.ReadResponseBody(apiResp, &links); err != nil {
+		diags.AddError("Rename Item Error",
+			fmt.Sprintf("Unable to read response of reading links of item %s, got error: %s", itemName, err))
+		return nil, diags
+	}
+
+	return links, diags
+}
+
+// copyMetadata copies all namespaces of the old item that are not managed by the resource to the new item.
+func (rename itemRename) copyMetadata(ctx context.Context, client *api.Client, metadata *api.EnrichedItemDTO_Metadata) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if metadata == nil {
+		return diags
+	}
+
+	for namespace := range metadata.AdditionalProperties {
+		if _, ok := rename.managedNamespaces[namespace]; ok || namespace == ownershipNamespace {
+			continue
+		}
+
+		value, config, _, err := enrichedMetadataToData(metadata, namespace, types.String{Null: true})
+		if err != nil {
+			diags.AddError("Rename Item Error",
+				fmt.Sprintf("Unable to convert config of metadata %s of item %s, got error: %s", namespace, rename.from, err))
+			continue
+		}
+
+		diags.Append(putItemMetadata(ctx, client, rename.to, namespace, value, config)...)
+	}
+
+	return diags
+}
+
+// moveMembers adds all members of the old item to the new item, the old item is a Group item in that case. Members
+// that were added to the new item already are only removed from the old item.
+func (rename itemRename) moveMembers(ctx context.Context, client *api.Client) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	fields := "name,groupNames"
+	apiResp, err := client.GetItems(ctx, &api.GetItemsParams{Fields: &fields})
+	if err != nil {
+		diags.AddError("Rename Item Error",
+			fmt.Sprintf("Unable to read members of item %s, got error: %s", rename.from, err))
+		return diags
+	}
+	if apiResp.StatusCode != 200 {
+		diags.AddError("Rename Item Error",
+			fmt.Sprintf("Unable to read members of item %s, got error: %s", rename.from, api.ReadResponseError(apiResp)))
+		return diags
+	}
+
+	var items []api.EnrichedItemDTO
+	if err := api.ReadResponseBody(apiResp, &items); err != nil {
+		diags.AddError("Rename Item Error",
+			fmt.Sprintf("Unable to read response of reading members of item %s, got error: %s", rename.from, err))
+		return diags
+	}
+
+	for _, item := range items {
+		if item.Name == nil || item.GroupNames == nil || !util.StringArrayContains(*item.GroupNames, rename.from) {
+			continue
+		}
+		member := *item.Name
+
+		if !util.StringArrayContains(*item.GroupNames, rename.to) {
+			apiResp, err := client.AddMemberToGroupItem(ctx, rename.to, member)
+			if err != nil {
+				diags.AddError("Rename Item Error",
+					fmt.Sprintf("Unable to add item %s to group %s, got error: %s", member, rename.to, err))
+				continue
+			}
+			if apiResp.StatusCode != 200 {
+				diags.AddError("Rename Item Error",
+					fmt.Sprintf("Unable to add item %s to group %s, got error: %s", member, rename.to, api.ReadResponseError(apiResp)))
+				continue
+			}
+		}
+
+		apiResp, err := client.RemoveMemberFromGroupItem(ctx, rename.from, member)
+		if err != nil {
+			diags.AddError("Rename Item Error",
+				fmt.Sprintf("Unable to remove item %s from group %s, got error: %s", member, rename.from, err))
+			continue
+		}
+		if apiResp.StatusCode != 200 && apiResp.StatusCode != 404 {
+			diags.AddError("Rename Item Error",
+				fmt.Sprintf("Unable to remove item %s from group %s, got error: %s", member, rename.from, api.ReadResponseError(apiResp)))
+		}
+	}
+
+	return diags
+}
+
+// copyPersistedStates copies the history of the old item since the configured start time to the new item, one request
+// per state. States the new item has persisted for the same time already, e.g. copied by an earlier rename that failed,
+// are skipped.
+func (rename itemRename) copyPersistedStates(ctx context.Context, client *api.Client) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var serviceId *string
+	if rename.persistenceServiceId != "" {
+		serviceId = &rename.persistenceServiceId
+	}
+
+	start := persistenceHistoryStart
+	if rename.persistenceStartTime != "" {
+		startTime, err := time.Parse(time.RFC3339, rename.persistenceStartTime)
+		if err != nil {
+			diags.AddError("Rename Item Error",
+				fmt.Sprintf("Unable to parse persistence start time %s, got error: %s", rename.persistenceStartTime, err))
+			return diags
+		}
+		start = startTime.Format(persistenceTimeFormat)
+	}
+
+	history, diags := readPersistedStates(ctx, client, rename.from, serviceId, start)
+	if diags.HasError() {
+		return diags
+	}
+
+	copied, diags := readPersistedStates(ctx, client, rename.to, serviceId, start)
+	if diags.HasError() {
+		return diags
+	}
+
+	copiedTimes := make(map[int64]bool, len(copied))
+	for _, data := range copied {
+		if data.Time != nil {
+			copiedTimes[*data.Time] = true
+		}
+	}
+
+	tflog.Debug(ctx, "Copying persisted states of renamed item", map[string]interface{}{
+		"from":   rename.from,
+		"to":     rename.to,
+		"states": len(history),
+		"copied": len(copiedTimes),
+	})
+
+	for _, data := range history {
+		if data.State == nil || data.Time == nil || copiedTimes[*data.Time] {
+			continue
+		}
+
+		params := &api.StoreItemDataInPersistenceServiceParams{
+			ServiceId: serviceId,
+			Time:      time.Unix(0, *data.Time*int64(time.Millisecond)).UTC().Format(persistenceTimeFormat),
+			State:     *data.State,
+		}
+		apiResp, err := client.StoreItemDataInPersistenceService(ctx, rename.to, params)
+		if err != nil {
+			diags.AddError("Rename Item Error",
+				fmt.Sprintf("Unable to store persisted state of item %s, got error: %s", rename.to, err))
+			return diags
+		}
+		if apiResp.StatusCode != 200 {
+			diags.AddError("Rename Item Error",
+				fmt.Sprintf("Unable to store persisted state of item %s, got error: %s", rename.to, api.ReadResponseError(apiResp)))
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// readPersistedStates reads the history of the given item since the given start time page by page. Items that have no
+// history are treated as empty.
+func readPersistedStates(ctx context.Context, client *api.Client, itemName string, serviceId *string, start string) ([]api.HistoryDataBean, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var states []api.HistoryDataBean
+
+	pageLength := int32(persistencePageLength)
+	for page := int32(0); ; page++ {
+		currentPage := page
+		apiResp, err := client.GetItemDataFromPersistenceService(ctx, itemName, &api.GetItemDataFromPersistenceServiceParams{
+			ServiceId:  serviceId,
+			Starttime:  &start,
+			Page:       &currentPage,
+			Pagelength: &pageLength,
+		})
+		if err != nil {
+			diags.AddError("Rename Item Error",
+				fmt.Sprintf("Unable to read persisted states of item %s, got error: %s", itemName, err))
+			return nil, diags
+		}
+		if apiResp.StatusCode == 404 {
+			return states, diags
+		}
+		if apiResp.StatusCode != 200 {
+			diags.AddError("Rename Item Error",
+				fmt.Sprintf("Unable to read persisted states of item %s, got error: %s", itemName, api.ReadResponseError(apiResp)))
+			return nil, diags
+		}
+
+		history := &api.ItemHistoryDTO{}
+		if err := api.ReadResponseBody(apiResp, history); err != nil {
+			diags.AddError("Rename Item Error",
+				fmt.Sprintf("Unable to read response of reading persisted states of item %s, got error: %s", itemName, err))
+			return nil, diags
+		}
+		if history.Data == nil {
+			return states, diags
+		}
+
+		states = append(states, *history.Data...)
+		if len(*history.Data) < persistencePageLength {
+			return states, diags
+		}
+	}
+}
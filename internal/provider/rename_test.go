@@ -0,0 +1,307 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/chris922/terraform-provider-openhab/internal/api"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testRenameServer answers the requests of renaming old_item to test_item and records all modifying requests. The
+// given responses replace the default responses, requests are matched including their query first. The given statuses
+// are returned instead of a successful response.
+func testRenameServer(t *testing.T, responses map[string]string, statuses map[string]int) (*api.Client, *[]string) {
+	var requests []string
+
+	defaultResponses := map[string]string{
+		"GET /items/old_item": `{"name": "old_item", "type": "Group", "metadata": {
+			"homekit": {"value": "Lighting", "config": {"name": "Light"}},
+			"terraform": {"value": "default"}
+		}}`,
+		"GET /links":                      `[{"itemName": "old_item", "channelUID": "astro:sun:home:rise#start", "configuration": {"profile": "system:default"}}]`,
+		"GET /items":                      `[{"name": "member_item", "groupNames": ["old_item"]}, {"name": "other_item", "groupNames": ["other_group"]}]`,
+		"GET /persistence/items/old_item": `{"name": "old_item", "data": [{"time": 1640995200000, "state": "21.5"}]}`,
+		"PUT /items/test_item":            `{"name": "test_item", "type": "Group", "label": "Test Number"}`,
+	}
+	for request, body := range responses {
+		defaultResponses[request] = body
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request := r.Method + " " + r.URL.Path
+		if r.Method != http.MethodGet {
+			requests = append(requests, request)
+		}
+
+		body, ok := defaultResponses[request+"?"+r.URL.RawQuery]
+		if !ok {
+			body, ok = defaultResponses[request]
+		}
+		if !ok && r.Method == http.MethodGet {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if status, ok := statuses[request]; ok {
+			w.WriteHeader(status)
+		} else if r.Method == http.MethodPut && r.URL.Path == "/items/test_item" {
+			w.WriteHeader(http.StatusCreated)
+		} else {
+			w.WriteHeader(http.StatusOK)
+		}
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := api.NewClient(server.URL)
+	if err != nil {
+		t.Fatalf("unable to create client: %s", err)
+	}
+
+	return client, &requests
+}
+
+// testRenameItem renames old_item to test_item and returns the resulting state.
+func testRenameItem(t *testing.T, client *api.Client) (itemResourceData, diag.Diagnostics) {
+	ctx := context.Background()
+
+	schema, diags := ItemResourceType{}.GetSchema(ctx)
+	if diags.HasError() {
+		t.Fatalf("unable to get schema: %v", diags)
+	}
+
+	prior := testItemData()
+	prior.Id = types.String{Value: "old_item"}
+	prior.Name = types.String{Value: "old_item"}
+	prior.Type = types.String{Value: "Group"}
+	prior.Category = types.String{Null: true}
+	prior.Tags = types.List{ElemType: types.StringType, Null: true}
+
+	planned := prior
+	planned.Name = types.String{Value: "test_item"}
+	planned.PreviousName = types.String{Value: "old_item"}
+	planned.CopyPersistence = types.Bool{Value: true}
+
+	config := testState(t, schema, &planned)
+	req := tfsdk.UpdateResourceRequest{
+		Config: tfsdk.Config{Schema: schema, Raw: config.Raw},
+		Plan:   tfsdk.Plan{Schema: schema, Raw: config.Raw},
+		State:  testState(t, schema, &prior),
+	}
+	resp := &tfsdk.UpdateResourceResponse{State: req.State}
+	itemResource{itemRegistry: itemRegistry{client: client}}.Update(ctx, req, resp)
+
+	var result itemResourceData
+	if diags := resp.State.Get(ctx, &result); diags.HasError() {
+		t.Fatalf("unable to get state: %v", diags)
+	}
+
+	return result, resp.Diagnostics
+}
+
+func testExpectRequests(t *testing.T, requests []string, expected []string) {
+	sort.Strings(requests)
+	if len(requests) != len(expected) {
+		t.Fatalf("expected requests %v, got %v", expected, requests)
+	}
+	for i := range expected {
+		if requests[i] != expected[i] {
+			t.Errorf("expected requests %v, got %v", expected, requests)
+			break
+		}
+	}
+}
+
+func TestItemUpdateRenamesItem(t *testing.T) {
+	client, requests := testRenameServer(t, nil, nil)
+
+	result, diags := testRenameItem(t, client)
+	if diags.HasError() {
+		t.Fatalf("unexpected error renaming item: %v", diags)
+	}
+
+	// the old item is only removed after everything was moved
+	if last := (*requests)[len(*requests)-1]; last != "DELETE /items/old_item" {
+		t.Errorf("expected old item to be removed last, got %s", last)
+	}
+	testExpectRequests(t, *requests, []string{
+		"DELETE /items/old_item",
+		"DELETE /items/old_item/members/member_item",
+		"DELETE /links/old_item/astro:sun:home:rise#start",
+		"PUT /items/test_item",
+		"PUT /items/test_item/members/member_item",
+		"PUT /items/test_item/metadata/homekit",
+		"PUT /links/test_item/astro:sun:home:rise#start",
+		"PUT /persistence/items/test_item",
+	})
+
+	if result.Name.Value != "test_item" {
+		t.Errorf("expected renamed item in state, got %q", result.Name.Value)
+	}
+}
+
+func TestItemUpdateKeepsOldItemIfRenameFails(t *testing.T) {
+	tests := map[string]string{
+		"link fails":   "PUT /links/test_item/astro:sun:home:rise#start",
+		"member fails": "PUT /items/test_item/members/member_item",
+	}
+
+	for name, failing := range tests {
+		client, requests := testRenameServer(t, nil, map[string]int{failing: http.StatusInternalServerError})
+
+		result, diags := testRenameItem(t, client)
+		if !diags.HasError() {
+			t.Errorf("%s: expected error renaming item", name)
+		}
+		for _, request := range *requests {
+			if request == "DELETE /items/old_item" {
+				t.Errorf("%s: expected old item to be kept", name)
+			}
+		}
+
+		// the next apply plans the rename again
+		if result.Name.Value != "old_item" || result.Id.Value != "old_item" || result.PreviousName.Value != "old_item" {
+			t.Errorf("%s: expected old item with previous name in state, got %q, %q and %q", name,
+				result.Name.Value, result.Id.Value, result.PreviousName.Value)
+		}
+	}
+}
+
+func TestItemUpdateFinishesFailedRename(t *testing.T) {
+	// the link and the member were moved, the persisted state was copied, but the link of the old item was not removed
+	client, requests := testRenameServer(t, map[string]string{
+		"GET /links?itemName=test_item":    `[{"itemName": "test_item", "channelUID": "astro:sun:home:rise#start"}]`,
+		"GET /items":                       `[{"name": "member_item", "groupNames": ["old_item", "test_item"]}]`,
+		"GET /persistence/items/test_item": `{"name": "test_item", "data": [{"time": 1640995200000, "state": "21.5"}]}`,
+	}, nil)
+
+	result, diags := testRenameItem(t, client)
+	if diags.HasError() {
+		t.Fatalf("unexpected error renaming item: %v", diags)
+	}
+
+	testExpectRequests(t, *requests, []string{
+		"DELETE /items/old_item",
+		"DELETE /items/old_item/members/member_item",
+		"DELETE /links/old_item/astro:sun:home:rise#start",
+		"PUT /items/test_item",
+		"PUT /items/test_item/metadata/homekit",
+	})
+
+	if result.Name.Value != "test_item" {
+		t.Errorf("expected renamed item in state, got %q", result.Name.Value)
+	}
+}
+
+func TestCopyPersistedStatesReadsPagesSinceStartTime(t *testing.T) {
+	var stored int
+	var starttimes []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			stored++
+			return
+		}
+		if r.URL.Path != "/persistence/items/old_item" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		starttimes = append(starttimes, r.URL.Query().Get("starttime"))
+		states := 1
+		if r.URL.Query().Get("page") == "0" {
+			states = persistencePageLength
+		}
+
+		var data []string
+		for i := 0; i < states; i++ {
+			data = append(data, fmt.Sprintf(`{"time": %d, "state": "21.5"}`, 1640995200000+len(starttimes)*persistencePageLength+i))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"name": "old_item", "data": [%s]}`, strings.Join(data, ","))
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := api.NewClient(server.URL)
+	if err != nil {
+		t.Fatalf("unable to create client: %s", err)
+	}
+
+	rename := itemRename{from: "old_item", to: "test_item", copyPersistence: true, persistenceStartTime: "2022-01-01T01:00:00+01:00"}
+	if diags := rename.copyPersistedStates(context.Background(), client); diags.HasError() {
+		t.Fatalf("unexpected error copying persisted states: %v", diags)
+	}
+
+	if stored != persistencePageLength+1 {
+		t.Errorf("expected states of both pages to be stored, got %d", stored)
+	}
+	if len(starttimes) != 2 || starttimes[0] != "2022-01-01T01:00:00.000+0100" || starttimes[1] != starttimes[0] {
+		t.Errorf("expected two pages read since the start time, got %v", starttimes)
+	}
+}
+
+func TestItemModifyPlanReplacesItemUnlessRenamed(t *testing.T) {
+	ctx := context.Background()
+
+	schema, diags := ItemResourceType{}.GetSchema(ctx)
+	if diags.HasError() {
+		t.Fatalf("unable to get schema: %v", diags)
+	}
+
+	tests := map[string]struct {
+		previousName    types.String
+		requiresReplace bool
+	}{
+		"without previous name": {
+			previousName:    types.String{Null: true},
+			requiresReplace: true,
+		},
+		"with previous name": {
+			previousName:    types.String{Value: "test_item"},
+			requiresReplace: false,
+		},
+		"with other previous name": {
+			previousName:    types.String{Value: "other_item"},
+			requiresReplace: true,
+		},
+	}
+
+	for name, test := range tests {
+		prior := testItemData()
+		planned := testItemData()
+		planned.Name = types.String{Value: "new_item"}
+		planned.PreviousName = test.previousName
+
+		plan := testState(t, schema, &planned)
+		req := tfsdk.ModifyResourcePlanRequest{
+			Config: tfsdk.Config{Schema: schema, Raw: plan.Raw},
+			Plan:   tfsdk.Plan{Schema: schema, Raw: plan.Raw},
+			State:  testState(t, schema, &prior),
+		}
+		resp := &tfsdk.ModifyResourcePlanResponse{Plan: req.Plan}
+		itemResource{}.ModifyPlan(ctx, req, resp)
+
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("%s: unexpected error: %v", name, resp.Diagnostics)
+		}
+
+		requiresReplace := false
+		for _, path := range resp.RequiresReplace {
+			if path.Equal(tftypes.NewAttributePath().WithAttributeName("name")) {
+				requiresReplace = true
+			}
+		}
+		if requiresReplace != test.requiresReplace {
+			t.Errorf("%s: expected replace to be %t, got %t", name, test.requiresReplace, requiresReplace)
+		}
+	}
+}
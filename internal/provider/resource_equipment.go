@@ -0,0 +1,23 @@
+package provider
+
+import (
+	"context"
+	"github.com/chris922/terraform-provider-openhab/internal/provider/validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+var equipmentKind = semanticGroupKind{
+	class:       validator.SemanticEquipment,
+	typeExample: "Lightbulb",
+}
+
+type EquipmentResourceType struct{}
+
+func (t EquipmentResourceType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return semanticGroupSchema(equipmentKind), nil
+}
+
+func (t EquipmentResourceType) NewResource(_ context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return newSemanticGroupResource(equipmentKind, in)
+}
@@ -2,8 +2,10 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/chris922/terraform-provider-openhab/internal/api"
+	"github.com/chris922/terraform-provider-openhab/internal/provider/planmodifier"
 	"github.com/chris922/terraform-provider-openhab/internal/provider/util"
 	"github.com/chris922/terraform-provider-openhab/internal/provider/validator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -11,6 +13,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"sort"
+	"strings"
 )
 
 type ItemResourceType struct{}
@@ -29,20 +33,66 @@ func (t ItemResourceType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagn
 					tfsdk.UseStateForUnknown(),
 				},
 			},
+			"server_uuid": {
+				MarkdownDescription: "UUID of the openHAB server the item was created on, used to detect an endpoint " +
+					"pointing to a different server",
+				Computed: true,
+				Type:     types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
 			"name": {
 				MarkdownDescription: "Item name",
 				Required:            true,
 				Type:                types.StringType,
 				PlanModifiers: tfsdk.AttributePlanModifiers{
 					tfsdk.UseStateForUnknown(),
-					tfsdk.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					validator.ItemNameValidator(),
+				},
+			},
+			"previous_name": {
+				MarkdownDescription: "Name the item had before, set it when changing `name` to rename the item instead of " +
+					"replacing it. A new item is created and links, metadata and group members are moved to it before " +
+					"the old item is removed. If anything cannot be moved, the old item is kept and the next apply finishes " +
+					"the rename.",
+				Optional: true,
+				Type:     types.StringType,
+				Validators: []tfsdk.AttributeValidator{
+					validator.ItemNameValidator(),
+				},
+			},
+			"copy_persistence": {
+				MarkdownDescription: "Copy the persisted states of the old item when the item is renamed, see " +
+					"`previous_name`. Every state is stored with its own request, so copying a long history takes a " +
+					"while and puts load on openHAB, limit it with `persistence_start_time`.",
+				Optional: true,
+				Type:     types.BoolType,
+			},
+			"persistence_service_id": {
+				MarkdownDescription: "Persistence service to copy the states of when the item is renamed, the default " +
+					"persistence service is used if not set",
+				Optional: true,
+				Type:     types.StringType,
+			},
+			"persistence_start_time": {
+				MarkdownDescription: "Time of the oldest persisted state copied when the item is renamed, as RFC 3339 " +
+					"timestamp, e.g. `2022-01-01T00:00:00Z`. The whole history is copied if not set.",
+				Optional: true,
+				Type:     types.StringType,
+				Validators: []tfsdk.AttributeValidator{
+					validator.TimestampValidator(),
 				},
 			},
 			"type": {
-				MarkdownDescription: "Item type",
-				Required:            true,
+				MarkdownDescription: "Item type. Changing the base type, e.g. from `Switch` to `Dimmer`, replaces the " +
+					"item, changing only the dimension of a `Number` item updates it in place.",
+				Required: true,
 				PlanModifiers: tfsdk.AttributePlanModifiers{
 					tfsdk.UseStateForUnknown(),
+					planmodifier.ItemTypeRequiresReplace(),
 				},
 				Type: types.StringType,
 				Validators: []tfsdk.AttributeValidator{
@@ -66,12 +116,16 @@ func (t ItemResourceType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagn
 				Type: types.StringType,
 			},
 			"tags": {
-				MarkdownDescription: "Item tags",
-				Optional:            true,
+				MarkdownDescription: "Item tags, tags of the semantic model like `LivingRoom`, `Lightbulb` or " +
+					"`Measurement` are checked to describe a single location, equipment or point",
+				Optional: true,
 				PlanModifiers: tfsdk.AttributePlanModifiers{
 					tfsdk.UseStateForUnknown(),
 				},
 				Type: types.ListType{ElemType: types.StringType},
+				Validators: []tfsdk.AttributeValidator{
+					validator.SemanticTagsValidator(),
+				},
 			},
 			"group_names": {
 				MarkdownDescription: "Item groups",
@@ -80,6 +134,74 @@ func (t ItemResourceType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagn
 					tfsdk.UseStateForUnknown(),
 				},
 				Type: types.ListType{ElemType: types.StringType},
+				Validators: []tfsdk.AttributeValidator{
+					validator.ItemNameValidator(),
+				},
+			},
+			"group_type": {
+				MarkdownDescription: "Base type of a Group item, determines the type of the aggregated state",
+				Optional:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+				Validators: []tfsdk.AttributeValidator{
+					validator.ItemTypeValidator(),
+				},
+			},
+			"unit": {
+				MarkdownDescription: "Unit of the state of a `Number:<Dimension>` item, e.g. `W` or `kW` for " +
+					"`Number:Power`. It is stored in the metadata namespace `unit` and requires openHAB 4.0 or newer.",
+				Optional: true,
+				Type:     types.StringType,
+			},
+			"metadata": {
+				MarkdownDescription: "Item metadata keyed by namespace, e.g. `stateDescription`, `expire` or `homekit`. " +
+					"Only the namespaces set here are managed, other namespaces of the item are left untouched. The " +
+					"namespace `terraform` is reserved to record the owner of the item.",
+				Optional: true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+				Attributes: tfsdk.MapNestedAttributes(map[string]tfsdk.Attribute{
+					"value": {
+						MarkdownDescription: "Metadata value, use `\" \"` if the namespace only uses config",
+						Required:            true,
+						Type:                types.StringType,
+					},
+					"config": {
+						MarkdownDescription: "Metadata config as JSON encoded object, use `jsonencode` to support typed and nested values",
+						Optional:            true,
+						Type:                types.StringType,
+					},
+				}, tfsdk.MapNestedAttributesOptions{}),
+			},
+			"adopt_existing": {
+				MarkdownDescription: "Take over an existing item with the same name when creating the item, even if " +
+					"it is not managed by the workspace of the provider. Overrides `adopt_existing_items` of the provider, " +
+					"taking over an item is reported as warning.",
+				Optional: true,
+				Type:     types.BoolType,
+			},
+		},
+
+		Blocks: map[string]tfsdk.Block{
+			"function": {
+				MarkdownDescription: "Aggregation function of a Group item",
+				NestingMode:         tfsdk.BlockNestingModeList,
+				MaxItems:            1,
+				Attributes: map[string]tfsdk.Attribute{
+					"name": {
+						MarkdownDescription: "Function name, e.g. `AND`, `OR`, `AVG` or `SUM`",
+						Required:            true,
+						Type:                types.StringType,
+					},
+					"params": {
+						MarkdownDescription: "Function params, e.g. the two states used by `AND` and `OR`",
+						Optional:            true,
+						Type:                types.ListType{ElemType: types.StringType},
+					},
+				},
 			},
 		},
 	}, nil
@@ -89,12 +211,14 @@ func (t ItemResourceType) NewResource(_ context.Context, in tfsdk.Provider) (tfs
 	provider, diags := ConvertProviderType(in)
 
 	return itemResource{
-		client: provider.Client,
+		itemRegistry:  newItemRegistry(provider),
+		serverVersion: provider.serverVersion,
 	}, diags
 }
 
 type itemResourceData struct {
-	Id types.String `tfsdk:"id"`
+	Id         types.String `tfsdk:"id"`
+	ServerUuid types.String `tfsdk:"server_uuid"`
 
 	// required
 	Name  types.String `tfsdk:"name"`
@@ -105,10 +229,42 @@ type itemResourceData struct {
 	Category   types.String `tfsdk:"category"`
 	Tags       types.List   `tfsdk:"tags"`
 	GroupNames types.List   `tfsdk:"group_names"`
+	GroupType  types.String `tfsdk:"group_type"`
+	Unit       types.String `tfsdk:"unit"`
+
+	Metadata map[string]itemMetadataData `tfsdk:"metadata"`
+
+	AdoptExisting types.Bool `tfsdk:"adopt_existing"`
+
+	PreviousName         types.String `tfsdk:"previous_name"`
+	CopyPersistence      types.Bool   `tfsdk:"copy_persistence"`
+	PersistenceServiceId types.String `tfsdk:"persistence_service_id"`
+	PersistenceStartTime types.String `tfsdk:"persistence_start_time"`
+
+	// blocks
+	Function []itemGroupFunctionData `tfsdk:"function"`
+}
+
+type itemMetadataData struct {
+	// required
+	Value types.String `tfsdk:"value"`
+
+	// optional
+	Config types.String `tfsdk:"config"`
+}
+
+type itemGroupFunctionData struct {
+	// required
+	Name types.String `tfsdk:"name"`
+
+	// optional
+	Params types.List `tfsdk:"params"`
 }
 
 type itemResource struct {
-	client *api.Client
+	itemRegistry
+
+	serverVersion *serverVersion
 }
 
 func (r itemResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
@@ -121,44 +277,24 @@ func (r itemResource) Create(ctx context.Context, req tfsdk.CreateResourceReques
 		return
 	}
 
-	body := api.AddOrUpdateItemInRegistryJSONRequestBody{
-		Name:  util.TypeToString(data.Name),
-		Label: util.TypeToString(data.Label),
-		Type:  util.TypeToString(data.Type),
+	diags = r.checkSemanticGroups(ctx, data)
+	resp.Diagnostics.Append(diags...)
 
-		Category:   util.TypeToString(data.Category),
-		Tags:       util.TypeToStringArray(data.Tags),
-		GroupNames: util.TypeToStringArray(data.GroupNames),
-	}
-	apiResp, err := r.client.AddOrUpdateItemInRegistry(ctx, data.Name.Value,
-		&api.AddOrUpdateItemInRegistryParams{}, body)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error",
-			fmt.Sprintf("Unable to create client, got error: %s", err))
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if apiResp.StatusCode == 200 {
-		resp.Diagnostics.AddWarning("Create Item Warning",
-			fmt.Sprintf("Item %s was not created, but updated", data.Name.Value))
-	} else if apiResp.StatusCode != 201 {
-		resp.Diagnostics.AddError("Create Item Error",
-			fmt.Sprintf("Unable to create item, got status: %s", apiResp.Status))
-		return
-	}
+	diags = r.createItem(ctx, &data)
+	resp.Diagnostics.Append(diags...)
 
-	apiRespObj := &api.EnrichedItemDTO{}
-	err = api.ReadResponseBody(apiResp, apiRespObj)
-	if err != nil {
-		resp.Diagnostics.AddError("Create Item Error",
-			fmt.Sprintf("Unable to read response of create item action, got error: %s", err))
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// store enriched item to resource
-	enrichedItemToData(data, apiRespObj)
+	diags = r.updateMetadata(ctx, data.Name.Value, nil, metadataWithUnit(data.Metadata, data.Unit))
+	resp.Diagnostics.Append(diags...)
 
-	tflog.Trace(ctx, "created an Item resource", "name", data.Name.Value)
+	tflog.Trace(ctx, "created an Item resource", map[string]interface{}{"name": data.Name.Value})
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
@@ -174,36 +310,39 @@ func (r itemResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, r
 		return
 	}
 
-	apiResp, err := r.client.GetItemByName(ctx, data.Name.Value,
-		&api.GetItemByNameParams{})
-	if err != nil {
-		resp.Diagnostics.AddError("Read Item Error",
-			fmt.Sprintf("Unable to read item, got error: %s", err))
-		return
+	// only request the managed metadata namespaces
+	managedMetadata := metadataWithUnit(data.Metadata, data.Unit)
+	params := &api.GetItemByNameParams{}
+	if len(managedMetadata) > 0 {
+		var namespaces []string
+		for namespace := range managedMetadata {
+			namespaces = append(namespaces, namespace)
+		}
+		sort.Strings(namespaces)
+
+		selector := strings.Join(namespaces, ",")
+		params.Metadata = &selector
 	}
 
-	if apiResp.StatusCode == 404 {
-		tflog.Debug(ctx, "Item not found, will be removed from state", "name", data.Name.Value)
+	apiRespObj, found, diags := r.readItem(ctx, &data, params)
+	resp.Diagnostics.Append(diags...)
 
-		resp.State.RemoveResource(ctx)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	if apiResp.StatusCode != 200 {
-		resp.Diagnostics.AddError("Read Item Error",
-			fmt.Sprintf("Unknown error reading item, got status: %s", apiResp.Status))
+	if !found {
+		resp.State.RemoveResource(ctx)
 		return
 	}
 
-	apiRespObj := &api.EnrichedItemDTO{}
-	err = api.ReadResponseBody(apiResp, apiRespObj)
-	if err != nil {
-		resp.Diagnostics.AddError("Read Item Error",
-			fmt.Sprintf("Unable to read response of read item action, got error: %s", err))
-		return
-	}
+	managedMetadata, diags = enrichedItemMetadataToData(managedMetadata, apiRespObj.Metadata)
+	resp.Diagnostics.Append(diags...)
 
-	// store enriched item to resource
-	enrichedItemToData(data, apiRespObj)
+	if data.Unit.Null {
+		data.Metadata = managedMetadata
+	} else {
+		data.Metadata, data.Unit = splitUnitMetadata(managedMetadata, data.Metadata == nil)
+	}
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
@@ -211,52 +350,92 @@ func (r itemResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, r
 
 func (r itemResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
 	var data itemResourceData
+	var state itemResourceData
 
 	diags := req.Config.Get(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	body := api.AddOrUpdateItemInRegistryJSONRequestBody{
-		Name:  &data.Name.Value,
-		Label: &data.Label.Value,
-		Type:  &data.Type.Value,
+	diags = r.checkSemanticGroups(ctx, data)
+	resp.Diagnostics.Append(diags...)
 
-		Category:   util.TypeToString(data.Category),
-		Tags:       util.TypeToStringArray(data.Tags),
-		GroupNames: util.TypeToStringArray(data.GroupNames),
-	}
-	apiResp, err := r.client.AddOrUpdateItemInRegistry(ctx, data.Name.Value,
-		&api.AddOrUpdateItemInRegistryParams{}, body)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error",
-			fmt.Sprintf("Unable to create client, got error: %s", err))
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if apiResp.StatusCode == 201 {
-		resp.Diagnostics.AddWarning("Update Item Warning",
-			fmt.Sprintf("Item %s was not updated, but created", data.Name.Value))
-	} else if apiResp.StatusCode != 200 {
-		resp.Diagnostics.AddError("Update Item Error",
-			fmt.Sprintf("Unable to update item, got status: %s", apiResp.Status))
-		return
+	// a changed name is only planned as update if the item is renamed, see ModifyPlan
+	var rename *itemRename
+	var renamedItem *api.EnrichedItemDTO
+	if data.Name.Value != state.Name.Value {
+		diags = r.ownership.checkItemCreatable(ctx, r.client, data.Name.Value, r.ownership.adopt(data.AdoptExisting))
+		resp.Diagnostics.Append(diags...)
+
+		var found bool
+		renamedItem, found, diags = readRenamedItem(ctx, r.client, state.Name.Value)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if found {
+			rename = &itemRename{
+				from:                 state.Name.Value,
+				to:                   data.Name.Value,
+				managedNamespaces:    metadataWithUnit(data.Metadata, data.Unit),
+				copyPersistence:      data.CopyPersistence.Value,
+				persistenceServiceId: data.PersistenceServiceId.Value,
+				persistenceStartTime: data.PersistenceStartTime.Value,
+			}
+		} else {
+			tflog.Debug(ctx, "Renamed item not found, creating the new item only", map[string]interface{}{"name": state.Name.Value})
+		}
 	}
 
-	apiRespObj := &api.EnrichedItemDTO{}
-	err = api.ReadResponseBody(apiResp, apiRespObj)
-	if err != nil {
-		resp.Diagnostics.AddError("Update Item Error",
-			fmt.Sprintf("Unable to read response of update item action, got error: %s", err))
+	// the new item is marked as owner even if the rename fails, so it is not taken for an item of someone else when
+	// the rename is retried
+	diags = r.updateItem(ctx, &data, state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// store enriched item to resource
-	enrichedItemToData(data, apiRespObj)
+	priorMetadata := metadataWithUnit(state.Metadata, state.Unit)
+	if data.Name.Value != state.Name.Value {
+		// the new item has no metadata yet
+		priorMetadata = nil
+	}
+
+	diags = r.updateMetadata(ctx, data.Name.Value, priorMetadata, metadataWithUnit(data.Metadata, data.Unit))
+	resp.Diagnostics.Append(diags...)
+
+	renamed := rename == nil
+	if rename != nil && !resp.Diagnostics.HasError() {
+		diags = rename.moveItem(ctx, r.client, renamedItem)
+		resp.Diagnostics.Append(diags...)
+		renamed = !diags.HasError()
+	}
+
+	if !renamed {
+		// the old item is kept if it could not be moved completely, keep it in the state as well, so the next apply
+		// plans the rename again and finishes it
+		tflog.Debug(ctx, "Rename of item failed, keeping the old item", map[string]interface{}{
+			"from": state.Name.Value,
+			"to":   data.Name.Value,
+		})
 
-	tflog.Trace(ctx, "updated an Item resource", "name", data.Name.Value)
+		state.PreviousName = data.PreviousName
+		data = state
+	}
+
+	tflog.Trace(ctx, "updated an Item resource", map[string]interface{}{"name": data.Name.Value})
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
@@ -272,18 +451,10 @@ func (r itemResource) Delete(ctx context.Context, req tfsdk.DeleteResourceReques
 		return
 	}
 
-	apiResp, err := r.client.RemoveItemFromRegistry(ctx, data.Name.Value)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error",
-			fmt.Sprintf("Unable to create client, got error: %s", err))
-		return
-	}
+	diags = r.deleteItem(ctx, data.Name.Value)
+	resp.Diagnostics.Append(diags...)
 
-	if apiResp.StatusCode == 404 {
-		tflog.Debug(ctx, "Planned to remove an item, but it was already removed", "name", data.Name.Value)
-	} else if apiResp.StatusCode != 200 {
-		resp.Diagnostics.AddError("Delete Item Error",
-			fmt.Sprintf("Unable to delete item, got status: %s", apiResp.Status))
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -294,13 +465,340 @@ func (r itemResource) ImportState(ctx context.Context, req tfsdk.ImportResourceS
 	tfsdk.ResourceImportStatePassthroughID(ctx, tftypes.NewAttributePath().WithAttributeName("name"), req, resp)
 }
 
-func enrichedItemToData(data itemResourceData, apiRespObj *api.EnrichedItemDTO) {
+func (r itemResource) ValidateConfig(ctx context.Context, req tfsdk.ValidateResourceConfigRequest, resp *tfsdk.ValidateResourceConfigResponse) {
+	var itemType, groupType types.String
+	var function types.List
+	var metadata types.Map
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("metadata"), &metadata)...)
+	if _, ok := metadata.Elems[ownershipNamespace]; ok {
+		resp.Diagnostics.AddAttributeError(tftypes.NewAttributePath().WithAttributeName("metadata"),
+			"Reserved Metadata Namespace",
+			fmt.Sprintf("The metadata namespace %s is used by the provider to record the owner of the item.", ownershipNamespace))
+	}
+
+	var unit types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("unit"), &unit)...)
+	if _, ok := metadata.Elems[unitNamespace]; ok && !unit.Null {
+		resp.Diagnostics.AddAttributeError(tftypes.NewAttributePath().WithAttributeName("metadata"),
+			"Conflicting Unit",
+			fmt.Sprintf("The metadata namespace %s can not be set together with the unit attribute.", unitNamespace))
+	}
+
+	var itemName, previousName types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("name"), &itemName)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("previous_name"), &previousName)...)
+	if !itemName.Unknown && !previousName.Unknown && !previousName.Null && previousName.Value == itemName.Value {
+		resp.Diagnostics.AddAttributeError(tftypes.NewAttributePath().WithAttributeName("previous_name"),
+			"Invalid Previous Name", "The previous name must differ from the name of the item.")
+	}
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("type"), &itemType)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("group_type"), &groupType)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("function"), &function)...)
+
+	if resp.Diagnostics.HasError() || itemType.Unknown || groupType.Unknown || function.Unknown {
+		return
+	}
+
+	isGroup := itemType.Value == "Group"
+
+	if !unit.Null && !unit.Unknown {
+		resp.Diagnostics.Append(validateItemUnit(itemType.Value, groupType, unit.Value)...)
+	}
+
+	if !groupType.Null {
+		if !isGroup {
+			resp.Diagnostics.AddAttributeError(tftypes.NewAttributePath().WithAttributeName("group_type"),
+				"Invalid Group Type", "A group type can only be set for items of type Group.")
+		} else if groupType.Value == "Group" {
+			resp.Diagnostics.AddAttributeError(tftypes.NewAttributePath().WithAttributeName("group_type"),
+				"Invalid Group Type", "Group is not a valid base type of a group.")
+		}
+	}
+
+	if len(function.Elems) == 0 {
+		return
+	}
+	if !isGroup {
+		resp.Diagnostics.AddAttributeError(tftypes.NewAttributePath().WithAttributeName("function"),
+			"Invalid Group Function", "A function can only be set for items of type Group.")
+		return
+	}
+
+	functionPath := tftypes.NewAttributePath().WithAttributeName("function").WithElementKeyInt(0)
+	// the function block may be unknown as a whole, e.g. if it is created by a dynamic block
+	functionObj, ok := function.Elems[0].(types.Object)
+	if !ok || functionObj.Unknown || functionObj.Null {
+		return
+	}
+	name, nameOk := functionObj.Attrs["name"].(types.String)
+	params, paramsOk := functionObj.Attrs["params"].(types.List)
+	if !nameOk || !paramsOk || name.Unknown || params.Unknown {
+		return
+	}
+
+	var paramValues []string
+	for _, param := range params.Elems {
+		paramValue := param.(types.String)
+		if paramValue.Unknown {
+			return
+		}
+		paramValues = append(paramValues, paramValue.Value)
+	}
+
+	err := validator.ValidateGroupFunction(groupType.Value, name.Value, paramValues)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(functionPath, "Invalid Group Function",
+			fmt.Sprintf("The group function is not valid for this group: %s", err))
+	}
+}
+
+func (r itemResource) ModifyPlan(ctx context.Context, req tfsdk.ModifyResourcePlanRequest, resp *tfsdk.ModifyResourcePlanResponse) {
+	// nothing to validate on destroy or if nothing changed
+	if req.Plan.Raw.IsNull() || req.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(r.modifyNamePlan(ctx, req, resp)...)
+	}
+
+	for _, attributeName := range []string{"type", "group_type"} {
+		path := tftypes.NewAttributePath().WithAttributeName(attributeName)
+
+		var itemType types.String
+		diags := req.Plan.GetAttribute(ctx, path, &itemType)
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() || itemType.Null || itemType.Unknown {
+			continue
+		}
+
+		resp.Diagnostics.Append(r.requireItemTypeFeatures(itemType.Value, path)...)
+	}
+
+	unitPath := tftypes.NewAttributePath().WithAttributeName("unit")
+
+	var unit types.String
+	diags := req.Plan.GetAttribute(ctx, unitPath, &unit)
+	resp.Diagnostics.Append(diags...)
+
+	if !diags.HasError() && !unit.Null {
+		resp.Diagnostics.Append(r.serverVersion.requireFeature(featureUnitMetadata, unitPath)...)
+	}
+}
+
+// modifyNamePlan replaces the item if its name changes, unless the item is renamed by setting previous_name to the
+// current name.
+func (r itemResource) modifyNamePlan(ctx context.Context, req tfsdk.ModifyResourcePlanRequest, resp *tfsdk.ModifyResourcePlanResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var plannedName, previousName, stateName types.String
+
+	namePath := tftypes.NewAttributePath().WithAttributeName("name")
+	diags.Append(req.Plan.GetAttribute(ctx, namePath, &plannedName)...)
+	diags.Append(req.Plan.GetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("previous_name"), &previousName)...)
+	diags.Append(req.State.GetAttribute(ctx, namePath, &stateName)...)
+
+	if diags.HasError() || plannedName.Equal(stateName) {
+		return diags
+	}
+
+	if !previousName.Null && !previousName.Unknown && previousName.Value == stateName.Value {
+		tflog.Debug(ctx, "Item is renamed", map[string]interface{}{"from": stateName.Value, "to": plannedName.Value})
+		return diags
+	}
+
+	resp.RequiresReplace = append(resp.RequiresReplace, namePath)
+
+	return diags
+}
+
+// requireItemTypeFeatures checks if the server supports the dimension of the given item type.
+func (r itemResource) requireItemTypeFeatures(itemType string, path *tftypes.AttributePath) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	baseType, dimension := validator.SplitItemType(itemType)
+	if feature, ok := dimensionFeatures[dimension]; ok && baseType == "Number" {
+		diags.Append(r.serverVersion.requireFeature(feature, path)...)
+	}
+
+	return diags
+}
+
+// validateItemUnit checks if the unit fits the dimension of the item, for groups the group type is used.
+func validateItemUnit(itemType string, groupType types.String, unit string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	path := tftypes.NewAttributePath().WithAttributeName("unit")
+
+	if itemType == "Group" && !groupType.Unknown && !groupType.Null {
+		itemType = groupType.Value
+	}
+	if itemType == "Group" && groupType.Unknown {
+		return diags
+	}
+
+	baseType, dimension := validator.SplitItemType(itemType)
+	if baseType != "Number" || dimension == "" {
+		diags.AddAttributeError(path, "Invalid Unit",
+			fmt.Sprintf("A unit can only be set for items of type Number:<Dimension>, got %s.", itemType))
+		return diags
+	}
+
+	err := validator.ValidateUnit(dimension, unit)
+	if errors.Is(err, validator.ErrUnknownUnit) {
+		diags.AddAttributeWarning(path, "Unknown Unit",
+			fmt.Sprintf("The unit %s is not known for the dimension %s, openHAB may reject it.", unit, dimension))
+	} else if err != nil {
+		diags.AddAttributeError(path, "Invalid Unit", fmt.Sprintf("The unit does not fit the item type: %s", err))
+	}
+
+	return diags
+}
+
+// checkSemanticGroups checks if an item tagged as location, equipment or point is only a member of groups of the
+// semantic model that can contain it, e.g. a point can not be part of another point.
+func (r itemResource) checkSemanticGroups(ctx context.Context, data itemResourceData) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tags := util.TypeToStringArray(data.Tags)
+	groupNames := util.TypeToStringArray(data.GroupNames)
+	if tags == nil || groupNames == nil {
+		return diags
+	}
+
+	class := semanticClass(*tags)
+	if class == "" {
+		return diags
+	}
+
+	for _, groupName := range *groupNames {
+		diags.Append(checkSemanticParent(ctx, r.client, class, groupName, false)...)
+	}
+
+	return diags
+}
+
+// enrichedItemToData stores the item returned by openHAB in the given resource data. The prior values of the resource
+// data are used to normalize lists, so that a different order or null vs. empty lists do not cause differences.
+func enrichedItemToData(data *itemResourceData, apiRespObj *api.EnrichedItemDTO, defaults itemDefaults) {
 	data.Id = util.StringToType(apiRespObj.Name)
 	data.Name = util.StringToType(apiRespObj.Name)
 	data.Label = util.StringToType(apiRespObj.Label)
 	data.Type = util.StringToType(apiRespObj.Type)
 
 	data.Category = util.StringToType(apiRespObj.Category)
-	data.Tags = util.StringArrayToType(apiRespObj.Tags)
-	data.GroupNames = util.StringArrayToType(apiRespObj.GroupNames)
+	// default tags and groups are added by the provider, so they are no difference to the configuration
+	tags := util.RemoveStringArrayDefaults(data.Tags, apiRespObj.Tags, defaults.tags)
+	groupNames := util.RemoveStringArrayDefaults(data.GroupNames, apiRespObj.GroupNames, defaults.groupNames)
+
+	data.Tags = util.NormalizeStringArrayToType(data.Tags, tags)
+	data.GroupNames = util.NormalizeStringArrayToType(data.GroupNames, groupNames)
+	data.GroupType = util.StringToType(apiRespObj.GroupType)
+	data.Function = groupFunctionToData(data.Function, apiRespObj.Function)
+}
+
+// metadataWithUnit returns the managed metadata namespaces including the unit namespace if a unit is set.
+func metadataWithUnit(metadata map[string]itemMetadataData, unit types.String) map[string]itemMetadataData {
+	if unit.Null || unit.Unknown {
+		return metadata
+	}
+
+	result := make(map[string]itemMetadataData, len(metadata)+1)
+	for namespace, value := range metadata {
+		result[namespace] = value
+	}
+	result[unitNamespace] = itemMetadataData{
+		Value:  unit,
+		Config: types.String{Null: true},
+	}
+
+	return result
+}
+
+// splitUnitMetadata separates the unit namespace read by metadataWithUnit from the other managed namespaces. If no
+// other namespace is managed, the metadata stays null.
+func splitUnitMetadata(metadata map[string]itemMetadataData, metadataNull bool) (map[string]itemMetadataData, types.String) {
+	unit := types.String{Null: true}
+	if unitMetadata, ok := metadata[unitNamespace]; ok {
+		unit = unitMetadata.Value
+	}
+
+	if metadataNull {
+		return nil, unit
+	}
+
+	result := make(map[string]itemMetadataData, len(metadata))
+	for namespace, value := range metadata {
+		if namespace != unitNamespace {
+			result[namespace] = value
+		}
+	}
+
+	return result, unit
+}
+
+// enrichedItemMetadataToData reads the managed namespaces out of the metadata of an enriched item, namespaces missing
+// on the server are dropped so that they are planned to be added again.
+func enrichedItemMetadataToData(prior map[string]itemMetadataData, metadata *api.EnrichedItemDTO_Metadata) (map[string]itemMetadataData, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if prior == nil {
+		return nil, diags
+	}
+
+	result := make(map[string]itemMetadataData, len(prior))
+	for namespace, priorMetadata := range prior {
+		value, config, found, err := enrichedMetadataToData(metadata, namespace, priorMetadata.Config)
+		if err != nil {
+			diags.AddError("Read Metadata Error",
+				fmt.Sprintf("Unable to convert config of metadata %s, got error: %s", namespace, err))
+			continue
+		}
+		if !found {
+			continue
+		}
+
+		result[namespace] = itemMetadataData{
+			Value:  value,
+			Config: config,
+		}
+	}
+
+	return result, diags
+}
+
+func groupFunctionDataToDTO(function []itemGroupFunctionData) *api.GroupFunctionDTO {
+	if len(function) == 0 {
+		return nil
+	}
+
+	return &api.GroupFunctionDTO{
+		Name:   util.TypeToString(function[0].Name),
+		Params: util.TypeToStringArray(function[0].Params),
+	}
+}
+
+func groupFunctionToData(prior []itemGroupFunctionData, function *api.GroupFunctionDTO) []itemGroupFunctionData {
+	// blocks are never null, so always use an empty list
+	if function == nil {
+		return []itemGroupFunctionData{}
+	}
+
+	// openHAB uses EQUALITY as default function for groups with a base type
+	if len(prior) == 0 && function.Name != nil && *function.Name == "EQUALITY" {
+		return []itemGroupFunctionData{}
+	}
+
+	params := util.StringArrayToType(function.Params)
+	if function.Params != nil && len(*function.Params) == 0 && (len(prior) == 0 || prior[0].Params.Null) {
+		params = util.StringArrayToType(nil)
+	}
+
+	return []itemGroupFunctionData{
+		{
+			Name:   util.StringToType(function.Name),
+			Params: params,
+		},
+	}
 }
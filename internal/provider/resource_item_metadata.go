@@ -0,0 +1,266 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/chris922/terraform-provider-openhab/internal/api"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strings"
+)
+
+type ItemMetadataResourceType struct{}
+
+func (t ItemMetadataResourceType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "OpenHAB Item Metadata of a single namespace, useful for items that are not managed by " +
+			"Terraform. Do not manage the same namespace using the `metadata` attribute of `openhab_item`.",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				MarkdownDescription: "Resource ID, item name and namespace separated by `:`",
+				Computed:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"item_name": {
+				MarkdownDescription: "Item name",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"namespace": {
+				MarkdownDescription: "Metadata namespace, e.g. `ga` or `homekit`",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"value": {
+				MarkdownDescription: "Metadata value, use `\" \"` if the namespace only uses config",
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+			"config": {
+				MarkdownDescription: "Metadata config as JSON encoded object, use `jsonencode` to support typed and nested values",
+				Optional:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+		},
+	}, nil
+}
+
+func (t ItemMetadataResourceType) NewResource(_ context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := ConvertProviderType(in)
+
+	return itemMetadataResource{
+		client:    provider.Client,
+		safeguard: provider.safeguard,
+	}, diags
+}
+
+type itemMetadataResourceData struct {
+	Id types.String `tfsdk:"id"`
+
+	// required
+	ItemName  types.String `tfsdk:"item_name"`
+	Namespace types.String `tfsdk:"namespace"`
+	Value     types.String `tfsdk:"value"`
+
+	// optional
+	Config types.String `tfsdk:"config"`
+}
+
+type itemMetadataResource struct {
+	client    *api.Client
+	safeguard *safeguard
+}
+
+func (r itemMetadataResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data itemMetadataResourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = putItemMetadata(ctx, r.client, data.ItemName.Value, data.Namespace.Value, data.Value, data.Config)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.String{Value: generateItemMetadataResourceId(data.ItemName.Value, data.Namespace.Value)}
+
+	tflog.Trace(ctx, "created an Item Metadata resource", map[string]interface{}{"item_name": data.ItemName.Value,
+		"namespace": data.Namespace.Value})
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r itemMetadataResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data itemMetadataResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, err := r.client.GetItemByName(ctx, data.ItemName.Value,
+		&api.GetItemByNameParams{Metadata: &data.Namespace.Value})
+	if err != nil {
+		resp.Diagnostics.AddError("Read Item Metadata Error",
+			fmt.Sprintf("Unable to read metadata %s of item %s, got error: %s", data.Namespace.Value, data.ItemName.Value, err))
+		return
+	}
+
+	if apiResp.StatusCode == 404 {
+		diags = r.safeguard.missing(ctx, "item", data.ItemName.Value)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		tflog.Debug(ctx, "Item of metadata not found, will be removed from state",
+			map[string]interface{}{"item_name": data.ItemName.Value, "namespace": data.Namespace.Value})
+
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if apiResp.StatusCode != 200 {
+		resp.Diagnostics.AddError("Read Item Metadata Error",
+			fmt.Sprintf("Unable to read metadata %s of item %s, got error: %s", data.Namespace.Value, data.ItemName.Value, api.ReadResponseError(apiResp)))
+		return
+	}
+
+	apiRespObj := &api.EnrichedItemDTO{}
+	err = api.ReadResponseBody(apiResp, apiRespObj)
+	if err != nil {
+		resp.Diagnostics.AddError("Read Item Metadata Error",
+			fmt.Sprintf("Unable to read response of reading metadata %s of item %s, got error: %s", data.Namespace.Value, data.ItemName.Value, err))
+		return
+	}
+
+	r.safeguard.found("item")
+
+	value, config, found, err := enrichedMetadataToData(apiRespObj.Metadata, data.Namespace.Value, data.Config)
+	if err != nil {
+		resp.Diagnostics.AddError("Read Item Metadata Error",
+			fmt.Sprintf("Unable to convert config of item metadata, got error: %s", err))
+		return
+	}
+
+	if !found {
+		tflog.Debug(ctx, "Item metadata not found, will be removed from state",
+			map[string]interface{}{"item_name": data.ItemName.Value, "namespace": data.Namespace.Value})
+
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Id = types.String{Value: generateItemMetadataResourceId(data.ItemName.Value, data.Namespace.Value)}
+	data.Value = value
+	data.Config = config
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r itemMetadataResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var data itemMetadataResourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = putItemMetadata(ctx, r.client, data.ItemName.Value, data.Namespace.Value, data.Value, data.Config)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.String{Value: generateItemMetadataResourceId(data.ItemName.Value, data.Namespace.Value)}
+
+	tflog.Trace(ctx, "updated an Item Metadata resource", map[string]interface{}{"item_name": data.ItemName.Value,
+		"namespace": data.Namespace.Value})
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r itemMetadataResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var data itemMetadataResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = removeItemMetadata(ctx, r.client, data.ItemName.Value, data.Namespace.Value)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r itemMetadataResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	itemName, namespace, ok := parseItemMetadataResourceId(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError("Import Item Metadata Error",
+			fmt.Sprintf("Unexpected import ID '%s', expected the format 'item:namespace'", req.ID))
+		return
+	}
+
+	diags := resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("item_name"), itemName)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("namespace"), namespace)
+	resp.Diagnostics.Append(diags...)
+}
+
+// generateItemMetadataResourceId joins item name and namespace, item names never contain a `:`.
+func generateItemMetadataResourceId(itemName string, namespace string) string {
+	return itemName + ":" + namespace
+}
+
+func parseItemMetadataResourceId(id string) (itemName string, namespace string, ok bool) {
+	parts := strings.SplitN(id, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", false
+	}
+
+	return parts[0], parts[1], true
+}
@@ -0,0 +1,219 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/chris922/terraform-provider-openhab/internal/api"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func testItemMetadataData() itemMetadataResourceData {
+	return itemMetadataResourceData{
+		Id:        types.String{Value: "test_item:stateDescription"},
+		ItemName:  types.String{Value: "test_item"},
+		Namespace: types.String{Value: "stateDescription"},
+		Value:     types.String{Value: " "},
+		Config:    types.String{Value: `{"pattern": "%.1f kWh", "readOnly": true, "options": {"min": 0}}`},
+	}
+}
+
+func testItemMetadataRead(t *testing.T, prior itemMetadataResourceData, status int, body string) (itemMetadataResourceData, bool) {
+	ctx := context.Background()
+
+	schema, diags := ItemMetadataResourceType{}.GetSchema(ctx)
+	if diags.HasError() {
+		t.Fatalf("unable to get schema: %v", diags)
+	}
+
+	r := itemMetadataResource{client: testServer(t, "/items/test_item", status, body)}
+
+	req := tfsdk.ReadResourceRequest{State: testState(t, schema, &prior)}
+	resp := &tfsdk.ReadResourceResponse{State: req.State}
+	r.Read(ctx, req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error reading item metadata: %v", resp.Diagnostics)
+	}
+	if resp.State.Raw.IsNull() {
+		return itemMetadataResourceData{}, false
+	}
+
+	var result itemMetadataResourceData
+	if diags := resp.State.Get(ctx, &result); diags.HasError() {
+		t.Fatalf("unable to get state: %v", diags)
+	}
+
+	return result, true
+}
+
+func TestItemMetadataCreateSendsTypedConfig(t *testing.T) {
+	ctx := context.Background()
+
+	schema, diags := ItemMetadataResourceType{}.GetSchema(ctx)
+	if diags.HasError() {
+		t.Fatalf("unable to get schema: %v", diags)
+	}
+
+	var body api.MetadataDTO
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/items/test_item/metadata/stateDescription" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("unable to decode request: %s", err)
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := api.NewClient(server.URL)
+	if err != nil {
+		t.Fatalf("unable to create client: %s", err)
+	}
+
+	data := testItemMetadataData()
+	data.Id = types.String{Unknown: true}
+	config := testState(t, schema, &data)
+
+	req := tfsdk.CreateResourceRequest{
+		Config: tfsdk.Config{Schema: schema, Raw: config.Raw},
+		Plan:   tfsdk.Plan{Schema: schema, Raw: config.Raw},
+	}
+	resp := &tfsdk.CreateResourceResponse{State: tfsdk.State{Schema: schema, Raw: tftypes.NewValue(schema.TerraformType(ctx), nil)}}
+	itemMetadataResource{client: client}.Create(ctx, req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error creating item metadata: %v", resp.Diagnostics)
+	}
+	if body.Config == nil || (*body.Config)["readOnly"] != true || (*body.Config)["pattern"] != "%.1f kWh" {
+		t.Errorf("expected typed config to be sent, got %v", body.Config)
+	}
+	if options, ok := (*body.Config)["options"].(map[string]interface{}); !ok || options["min"] != 0.0 {
+		t.Errorf("expected nested config to be sent, got %v", body.Config)
+	}
+
+	var result itemMetadataResourceData
+	resp.Diagnostics.Append(resp.State.Get(ctx, &result)...)
+	if result.Id.Value != "test_item:stateDescription" || !result.Config.Equal(data.Config) {
+		t.Errorf("expected id and configured config in state, got %v", result)
+	}
+}
+
+func TestItemMetadataReadComparesConfigSemantically(t *testing.T) {
+	prior := testItemMetadataData()
+
+	// openHAB converts config values based on config descriptions, the prior formatting is kept
+	result, found := testItemMetadataRead(t, prior, http.StatusOK, `{"name": "test_item", "metadata": {
+		"stateDescription": {"value": " ", "config": {"readOnly": "true", "options": {"min": "0"}, "pattern": "%.1f kWh"}}
+	}}`)
+	if !found || !result.Config.Equal(prior.Config) {
+		t.Errorf("expected prior config to be kept, got %v", result.Config)
+	}
+
+	result, _ = testItemMetadataRead(t, prior, http.StatusOK, `{"name": "test_item", "metadata": {
+		"stateDescription": {"value": " ", "config": {"readOnly": false, "options": {"min": 0}, "pattern": "%.1f kWh"}}
+	}}`)
+	if result.Config.Value != `{"options":{"min":0},"pattern":"%.1f kWh","readOnly":false}` {
+		t.Errorf("expected changed config to be read, got %v", result.Config)
+	}
+
+	prior.Config = types.String{Null: true}
+	result, _ = testItemMetadataRead(t, prior, http.StatusOK, `{"name": "test_item", "metadata": {
+		"stateDescription": {"value": "x", "config": {}}
+	}}`)
+	if !result.Config.Null || result.Value.Value != "x" {
+		t.Errorf("expected empty config to be read as null and value to be read, got %v", result)
+	}
+}
+
+func TestItemMetadataReadRemovesMissingNamespace(t *testing.T) {
+	if _, found := testItemMetadataRead(t, testItemMetadataData(), http.StatusOK,
+		`{"name": "test_item", "metadata": {}}`); found {
+		t.Errorf("expected missing namespace to be removed from state")
+	}
+
+	if _, found := testItemMetadataRead(t, testItemMetadataData(), http.StatusOK,
+		`{"name": "test_item"}`); found {
+		t.Errorf("expected item without metadata to be removed from state")
+	}
+
+	if _, found := testItemMetadataRead(t, testItemMetadataData(), http.StatusNotFound, `{}`); found {
+		t.Errorf("expected metadata of missing item to be removed from state")
+	}
+}
+
+func TestItemMetadataDeleteIgnoresMissingNamespace(t *testing.T) {
+	ctx := context.Background()
+
+	schema, diags := ItemMetadataResourceType{}.GetSchema(ctx)
+	if diags.HasError() {
+		t.Fatalf("unable to get schema: %v", diags)
+	}
+
+	data := testItemMetadataData()
+	r := itemMetadataResource{client: testServer(t, "/items/test_item/metadata/stateDescription",
+		http.StatusNotFound, `{}`)}
+
+	req := tfsdk.DeleteResourceRequest{State: testState(t, schema, &data)}
+	resp := &tfsdk.DeleteResourceResponse{State: req.State}
+	r.Delete(ctx, req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("unexpected error deleting missing metadata: %v", resp.Diagnostics)
+	}
+	if !resp.State.Raw.IsNull() {
+		t.Errorf("expected metadata to be removed from state")
+	}
+}
+
+func TestItemMetadataImport(t *testing.T) {
+	ctx := context.Background()
+
+	schema, diags := ItemMetadataResourceType{}.GetSchema(ctx)
+	if diags.HasError() {
+		t.Fatalf("unable to get schema: %v", diags)
+	}
+
+	r := itemMetadataResource{client: testServer(t, "/items/test_item", http.StatusOK, `{"name": "test_item", "metadata": {
+		"homekit": {"value": "Lighting", "config": {"name": "Light"}}
+	}}`)}
+
+	importResp := &tfsdk.ImportResourceStateResponse{
+		State: tfsdk.State{Schema: schema, Raw: tftypes.NewValue(schema.TerraformType(ctx), nil)},
+	}
+	r.ImportState(ctx, tfsdk.ImportResourceStateRequest{ID: "test_item:homekit"}, importResp)
+	if importResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error importing item metadata: %v", importResp.Diagnostics)
+	}
+
+	readResp := &tfsdk.ReadResourceResponse{State: importResp.State}
+	r.Read(ctx, tfsdk.ReadResourceRequest{State: importResp.State}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error reading item metadata: %v", readResp.Diagnostics)
+	}
+
+	var result itemMetadataResourceData
+	if diags := readResp.State.Get(ctx, &result); diags.HasError() {
+		t.Fatalf("unable to get state: %v", diags)
+	}
+	if result.Id.Value != "test_item:homekit" || result.Value.Value != "Lighting" ||
+		result.Config.Value != `{"name":"Light"}` {
+		t.Errorf("expected imported metadata, got %v", result)
+	}
+
+	invalidResp := &tfsdk.ImportResourceStateResponse{
+		State: tfsdk.State{Schema: schema, Raw: tftypes.NewValue(schema.TerraformType(ctx), nil)},
+	}
+	r.ImportState(ctx, tfsdk.ImportResourceStateRequest{ID: "test_item"}, invalidResp)
+	if !invalidResp.Diagnostics.HasError() {
+		t.Errorf("expected error for import ID without namespace")
+	}
+}
@@ -0,0 +1,397 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/chris922/terraform-provider-openhab/internal/api"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testServer starts a server answering every request to the given path with the given status and body.
+func testServer(t *testing.T, path string, status int, body string) *api.Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != path {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := api.NewClient(server.URL)
+	if err != nil {
+		t.Fatalf("unable to create client: %s", err)
+	}
+
+	return client
+}
+
+// testState creates a state of the given schema containing the given resource data.
+func testState(t *testing.T, schema tfsdk.Schema, data interface{}) tfsdk.State {
+	ctx := context.Background()
+
+	state := tfsdk.State{
+		Schema: schema,
+		Raw:    tftypes.NewValue(schema.TerraformType(ctx), nil),
+	}
+	if diags := state.Set(ctx, data); diags.HasError() {
+		t.Fatalf("unable to set state: %v", diags)
+	}
+
+	return state
+}
+
+func testStringList(values ...string) types.List {
+	elems := []attr.Value{}
+	for _, v := range values {
+		elems = append(elems, types.String{Value: v})
+	}
+
+	return types.List{ElemType: types.StringType, Elems: elems}
+}
+
+func testItemRead(t *testing.T, prior itemResourceData, status int, body string) (itemResourceData, *tfsdk.ReadResourceResponse) {
+	ctx := context.Background()
+
+	schema, diags := ItemResourceType{}.GetSchema(ctx)
+	if diags.HasError() {
+		t.Fatalf("unable to get schema: %v", diags)
+	}
+
+	r := itemResource{itemRegistry: itemRegistry{client: testServer(t, "/items/test_item", status, body)}}
+
+	req := tfsdk.ReadResourceRequest{State: testState(t, schema, &prior)}
+	resp := &tfsdk.ReadResourceResponse{State: req.State}
+	r.Read(ctx, req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error reading item: %v", resp.Diagnostics)
+	}
+
+	var result itemResourceData
+	if !resp.State.Raw.IsNull() {
+		if diags := resp.State.Get(ctx, &result); diags.HasError() {
+			t.Fatalf("unable to get state: %v", diags)
+		}
+	}
+
+	return result, resp
+}
+
+func testItemData() itemResourceData {
+	return itemResourceData{
+		Id:         types.String{Value: "test_item"},
+		ServerUuid: types.String{Null: true},
+		Name:       types.String{Value: "test_item"},
+		Label:      types.String{Value: "Test Number"},
+		Type:       types.String{Value: "Number"},
+		Category:   types.String{Value: "energy"},
+		Tags:       testStringList("tag1", "tag2"),
+		GroupNames: types.List{ElemType: types.StringType, Null: true},
+		GroupType:  types.String{Null: true},
+		Unit:       types.String{Null: true},
+		Function:   []itemGroupFunctionData{},
+
+		AdoptExisting:        types.Bool{Null: true},
+		PreviousName:         types.String{Null: true},
+		CopyPersistence:      types.Bool{Null: true},
+		PersistenceServiceId: types.String{Null: true},
+		PersistenceStartTime: types.String{Null: true},
+	}
+}
+
+func TestItemReadDetectsOutOfBandChanges(t *testing.T) {
+	result, _ := testItemRead(t, testItemData(), http.StatusOK, `{
+		"name": "test_item",
+		"type": "Number:Power",
+		"label": "Changed in UI",
+		"category": "light",
+		"tags": ["tag1", "tag3"],
+		"groupNames": ["group_1"]
+	}`)
+
+	if result.Label.Value != "Changed in UI" {
+		t.Errorf("expected label to be read from server, got %q", result.Label.Value)
+	}
+	if result.Type.Value != "Number:Power" {
+		t.Errorf("expected type to be read from server, got %q", result.Type.Value)
+	}
+	if result.Category.Value != "light" {
+		t.Errorf("expected category to be read from server, got %q", result.Category.Value)
+	}
+	if !result.Tags.Equal(testStringList("tag1", "tag3")) {
+		t.Errorf("expected tags to be read from server, got %v", result.Tags)
+	}
+	if !result.GroupNames.Equal(testStringList("group_1")) {
+		t.Errorf("expected group names to be read from server, got %v", result.GroupNames)
+	}
+}
+
+func TestItemReadIgnoresOrderAndEmptyLists(t *testing.T) {
+	prior := testItemData()
+	result, _ := testItemRead(t, prior, http.StatusOK, `{
+		"name": "test_item",
+		"type": "Number",
+		"label": "Test Number",
+		"category": "energy",
+		"tags": ["tag2", "tag1"],
+		"groupNames": []
+	}`)
+
+	if !result.Tags.Equal(prior.Tags) {
+		t.Errorf("expected tags in prior order, got %v", result.Tags)
+	}
+	if !result.GroupNames.Null {
+		t.Errorf("expected empty group names to stay null, got %v", result.GroupNames)
+	}
+}
+
+func TestItemReadDetectsMetadataChanges(t *testing.T) {
+	prior := testItemData()
+	prior.Metadata = map[string]itemMetadataData{
+		"expire": {
+			Value:  types.String{Value: "1h"},
+			Config: types.String{Null: true},
+		},
+		"stateDescription": {
+			Value:  types.String{Value: " "},
+			Config: types.String{Value: `{"readOnly": true}`},
+		},
+	}
+
+	result, _ := testItemRead(t, prior, http.StatusOK, `{
+		"name": "test_item",
+		"type": "Number",
+		"label": "Test Number",
+		"category": "energy",
+		"tags": ["tag1", "tag2"],
+		"metadata": {
+			"stateDescription": {"value": " ", "config": {"readOnly": false}}
+		}
+	}`)
+
+	if _, ok := result.Metadata["expire"]; ok {
+		t.Errorf("expected removed metadata namespace to be dropped, got %v", result.Metadata)
+	}
+	if config := result.Metadata["stateDescription"].Config.Value; config != `{"readOnly":false}` {
+		t.Errorf("expected changed metadata config to be read from server, got %q", config)
+	}
+}
+
+func TestItemReadRemovesMissingItem(t *testing.T) {
+	_, resp := testItemRead(t, testItemData(), http.StatusNotFound, `{}`)
+
+	if !resp.State.Raw.IsNull() {
+		t.Errorf("expected missing item to be removed from state")
+	}
+}
+
+func TestItemDeleteReportsServerMessage(t *testing.T) {
+	ctx := context.Background()
+
+	schema, diags := ItemResourceType{}.GetSchema(ctx)
+	if diags.HasError() {
+		t.Fatalf("unable to get schema: %v", diags)
+	}
+
+	r := itemResource{itemRegistry: itemRegistry{client: testServer(t, "/items/test_item", http.StatusMethodNotAllowed,
+		`{"error": {"message": "Item test_item is not editable.", "http-code": 405}}`)}}
+
+	data := testItemData()
+	req := tfsdk.DeleteResourceRequest{State: testState(t, schema, &data)}
+	resp := &tfsdk.DeleteResourceResponse{State: req.State}
+	r.Delete(ctx, req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatalf("expected error deleting item")
+	}
+	detail := resp.Diagnostics[0].Detail()
+	if !strings.Contains(detail, "test_item") || !strings.Contains(detail, "Item test_item is not editable.") {
+		t.Errorf("expected error to contain item name and message of openHAB, got %q", detail)
+	}
+}
+
+func TestItemReadIgnoresDefaultTagsAndGroups(t *testing.T) {
+	defaults := itemDefaults{tags: []string{"terraform", "tag2"}, groupNames: []string{"gManaged"}}
+	tags := []string{"tag1", "terraform", "tag2"}
+	groupNames := []string{"gManaged"}
+
+	data := testItemData()
+	enrichedItemToData(&data, &api.EnrichedItemDTO{Tags: &tags, GroupNames: &groupNames}, defaults)
+
+	if len(data.Tags.Elems) != 2 || data.Tags.Elems[0].(types.String).Value != "tag1" ||
+		data.Tags.Elems[1].(types.String).Value != "tag2" {
+		t.Errorf("expected only configured tags to be read, got %v", data.Tags)
+	}
+	if !data.GroupNames.Null {
+		t.Errorf("expected default group to be ignored, got %v", data.GroupNames)
+	}
+
+	// an import has no prior value, so all defaults are ignored
+	data = testItemData()
+	data.Tags = types.List{ElemType: types.StringType, Null: true}
+	enrichedItemToData(&data, &api.EnrichedItemDTO{Tags: &tags}, defaults)
+
+	if len(data.Tags.Elems) != 1 || data.Tags.Elems[0].(types.String).Value != "tag1" {
+		t.Errorf("expected default tags to be ignored on import, got %v", data.Tags)
+	}
+}
+
+func TestItemDataToBodySkipsDefaultGroupOfItself(t *testing.T) {
+	r := itemRegistry{defaults: itemDefaults{groupNames: []string{"gManaged", "gTerraform"}}}
+
+	data := testItemData()
+	data.Name = types.String{Value: "gManaged"}
+	data.Type = types.String{Value: "Group"}
+	data.GroupNames = testStringList("gHeating")
+
+	body := r.itemDataToBody(data)
+	if body.GroupNames == nil || len(*body.GroupNames) != 2 ||
+		(*body.GroupNames)[0] != "gHeating" || (*body.GroupNames)[1] != "gTerraform" {
+		t.Errorf("expected default group not to be added to itself, got %v", body.GroupNames)
+	}
+
+	data.Name = types.String{Value: "test_item"}
+	body = r.itemDataToBody(data)
+	if body.GroupNames == nil || len(*body.GroupNames) != 3 {
+		t.Errorf("expected all default groups to be added to other items, got %v", body.GroupNames)
+	}
+}
+
+func TestItemReadReadsUnit(t *testing.T) {
+	prior := testItemData()
+	prior.Type = types.String{Value: "Number:Power"}
+	prior.Unit = types.String{Value: "W"}
+
+	result, _ := testItemRead(t, prior, http.StatusOK, `{
+		"name": "test_item",
+		"type": "Number:Power",
+		"label": "Test Number",
+		"category": "energy",
+		"tags": ["tag1", "tag2"],
+		"metadata": {
+			"unit": {"value": "kW"}
+		}
+	}`)
+
+	if result.Unit.Value != "kW" {
+		t.Errorf("expected unit to be read from server, got %v", result.Unit)
+	}
+	if result.Metadata != nil {
+		t.Errorf("expected unmanaged metadata to stay null, got %v", result.Metadata)
+	}
+}
+
+func TestItemValidateUnit(t *testing.T) {
+	tests := []struct {
+		itemType    string
+		groupType   types.String
+		unit        string
+		expectError bool
+	}{
+		{itemType: "Number:Power", groupType: types.String{Null: true}, unit: "kW", expectError: false},
+		{itemType: "Number:Temperature", groupType: types.String{Null: true}, unit: "°C", expectError: false},
+		{itemType: "Group", groupType: types.String{Value: "Number:Energy"}, unit: "kWh", expectError: false},
+		{itemType: "Number:Power", groupType: types.String{Null: true}, unit: "kWh", expectError: true},
+		{itemType: "Number", groupType: types.String{Null: true}, unit: "W", expectError: true},
+		{itemType: "Switch", groupType: types.String{Null: true}, unit: "W", expectError: true},
+	}
+
+	for _, test := range tests {
+		diags := validateItemUnit(test.itemType, test.groupType, test.unit)
+		if diags.HasError() != test.expectError {
+			t.Errorf("expected error for unit %s of type %s to be %t, got %v", test.unit, test.itemType,
+				test.expectError, diags)
+		}
+	}
+}
+
+func testItemValidateConfig(t *testing.T, data itemResourceData,
+	transform func(*tftypes.AttributePath, tftypes.Value) (tftypes.Value, error)) diag.Diagnostics {
+	ctx := context.Background()
+
+	schema, diags := ItemResourceType{}.GetSchema(ctx)
+	if diags.HasError() {
+		t.Fatalf("unable to get schema: %v", diags)
+	}
+
+	raw := testState(t, schema, &data).Raw
+	if transform != nil {
+		var err error
+		if raw, err = tftypes.Transform(raw, transform); err != nil {
+			t.Fatalf("unable to transform config: %s", err)
+		}
+	}
+
+	req := tfsdk.ValidateResourceConfigRequest{Config: tfsdk.Config{Schema: schema, Raw: raw}}
+	resp := &tfsdk.ValidateResourceConfigResponse{}
+	itemResource{}.ValidateConfig(ctx, req, resp)
+
+	return resp.Diagnostics
+}
+
+func TestItemValidateConfigGroupFunction(t *testing.T) {
+	data := testItemData()
+	data.Type = types.String{Value: "Group"}
+	data.GroupType = types.String{Value: "Switch"}
+	data.Tags = types.List{ElemType: types.StringType, Null: true}
+
+	data.Function = []itemGroupFunctionData{{Name: types.String{Value: "OR"}, Params: testStringList("ON", "OFF")}}
+	if diags := testItemValidateConfig(t, data, nil); diags.HasError() {
+		t.Errorf("unexpected error: %v", diags)
+	}
+
+	data.Function = []itemGroupFunctionData{{Name: types.String{Value: "OR"}, Params: testStringList("ON")}}
+	if diags := testItemValidateConfig(t, data, nil); !diags.HasError() {
+		t.Errorf("expected error for missing param")
+	}
+
+	data.Type = types.String{Value: "Switch"}
+	data.GroupType = types.String{Null: true}
+	data.Function = []itemGroupFunctionData{{Name: types.String{Value: "EQUALITY"}, Params: testStringList()}}
+	if diags := testItemValidateConfig(t, data, nil); !diags.HasError() {
+		t.Errorf("expected error for function of a non-group item")
+	}
+}
+
+func TestItemValidateConfigUnknownGroupFunction(t *testing.T) {
+	data := testItemData()
+	data.Type = types.String{Value: "Group"}
+	data.GroupType = types.String{Value: "Switch"}
+	data.Tags = types.List{ElemType: types.StringType, Null: true}
+	data.Function = []itemGroupFunctionData{{Name: types.String{Value: "OR"}, Params: testStringList("ON")}}
+
+	functionPath := tftypes.NewAttributePath().WithAttributeName("function")
+	unknown := func(path *tftypes.AttributePath) func(*tftypes.AttributePath, tftypes.Value) (tftypes.Value, error) {
+		return func(p *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+			if p.Equal(path) {
+				return tftypes.NewValue(v.Type(), tftypes.UnknownValue), nil
+			}
+			return v, nil
+		}
+	}
+
+	tests := map[string]*tftypes.AttributePath{
+		"unknown block list": functionPath,
+		"unknown block":      functionPath.WithElementKeyInt(0),
+		"unknown params":     functionPath.WithElementKeyInt(0).WithAttributeName("params"),
+		"unknown param":      functionPath.WithElementKeyInt(0).WithAttributeName("params").WithElementKeyInt(0),
+	}
+
+	for name, path := range tests {
+		if diags := testItemValidateConfig(t, data, unknown(path)); diags.HasError() {
+			t.Errorf("%s: expected validation to be skipped, got %v", name, diags)
+		}
+	}
+}
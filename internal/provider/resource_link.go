@@ -5,11 +5,13 @@ import (
 	"fmt"
 	"github.com/chris922/terraform-provider-openhab/internal/api"
 	"github.com/chris922/terraform-provider-openhab/internal/provider/util"
+	"github.com/chris922/terraform-provider-openhab/internal/provider/validator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strings"
 )
 
 type LinkResourceType struct{}
@@ -17,17 +19,27 @@ type LinkResourceType struct{}
 func (t LinkResourceType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
 	return tfsdk.Schema{
 		// This description is used by the documentation generator and the language server.
-		MarkdownDescription: "OpenHAB Link between an Item and a Channel.",
+		MarkdownDescription: "OpenHAB Link between an Item and a Channel. The item type is validated against the " +
+			"item type of the channel, trigger channels require a trigger profile supported by the channel type.",
 
 		Attributes: map[string]tfsdk.Attribute{
 			"id": {
-				MarkdownDescription: "Resource ID",
+				MarkdownDescription: "Resource ID, item name and channel UID separated by `-`, e.g. `test_item-modbus:data:meter:current`",
 				Computed:            true,
 				Type:                types.StringType,
 				PlanModifiers: tfsdk.AttributePlanModifiers{
 					tfsdk.UseStateForUnknown(),
 				},
 			},
+			"server_uuid": {
+				MarkdownDescription: "UUID of the openHAB server the link was created on, used to detect an endpoint " +
+					"pointing to a different server",
+				Computed: true,
+				Type:     types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
 			"item_name": {
 				MarkdownDescription: "Item name",
 				Required:            true,
@@ -36,6 +48,9 @@ func (t LinkResourceType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagn
 					tfsdk.UseStateForUnknown(),
 					tfsdk.RequiresReplace(),
 				},
+				Validators: []tfsdk.AttributeValidator{
+					validator.ItemNameValidator(),
+				},
 			},
 			"channel_uid": {
 				MarkdownDescription: "Channel UID",
@@ -47,15 +62,24 @@ func (t LinkResourceType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagn
 				Type: types.StringType,
 			},
 			"configuration": {
-				MarkdownDescription: "Link configuration",
-				Optional:            true,
+				MarkdownDescription: "Link configuration, changes are applied in-place. All values are sent as " +
+					"strings, use `configuration_json` for typed values. Conflicts with `configuration_json`.",
+				Optional: true,
 				PlanModifiers: tfsdk.AttributePlanModifiers{
 					tfsdk.UseStateForUnknown(),
-					tfsdk.RequiresReplace(),
 				},
-				// TODO: can the values also be e.g. a number?
 				Type: types.MapType{ElemType: types.StringType},
 			},
+			"configuration_json": {
+				MarkdownDescription: "Link configuration as JSON encoded object, use `jsonencode` to send typed values " +
+					"like numbers or booleans, e.g. for the `system:offset` profile. Changes are applied in-place. " +
+					"Conflicts with `configuration`.",
+				Optional: true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
 		},
 	}, nil
 }
@@ -64,23 +88,27 @@ func (t LinkResourceType) NewResource(_ context.Context, in tfsdk.Provider) (tfs
 	provider, diags := ConvertProviderType(in)
 
 	return linkResource{
-		client: provider.Client,
+		client:    provider.Client,
+		safeguard: provider.safeguard,
 	}, diags
 }
 
 type linkResourceData struct {
-	Id types.String `tfsdk:"id"`
+	Id         types.String `tfsdk:"id"`
+	ServerUuid types.String `tfsdk:"server_uuid"`
 
 	// required
 	ItemName   types.String `tfsdk:"item_name"`
 	ChannelUid types.String `tfsdk:"channel_uid"`
 
 	// optional
-	Configuration types.Map `tfsdk:"configuration"`
+	Configuration     types.Map    `tfsdk:"configuration"`
+	ConfigurationJson types.String `tfsdk:"configuration_json"`
 }
 
 type linkResource struct {
-	client *api.Client
+	client    *api.Client
+	safeguard *safeguard
 }
 
 func (r linkResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
@@ -93,30 +121,47 @@ func (r linkResource) Create(ctx context.Context, req tfsdk.CreateResourceReques
 		return
 	}
 
+	// things and items may have been created in the same run, so they could not be validated during plan
+	profile, _ := linkProfile(data)
+	diags = validateLinkCompatibility(ctx, r.client, data.ItemName.Value, data.ChannelUid.Value, profile)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configuration, err := linkDataToConfiguration(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Link Error",
+			fmt.Sprintf("Unable to convert link configuration, got error: %s", err))
+		return
+	}
+
 	body := api.LinkItemToChannelJSONRequestBody{
 		ItemName:   &data.ItemName.Value,
 		ChannelUID: &data.ChannelUid.Value,
 
-		Configuration: util.TypeToStringMap(data.Configuration),
+		Configuration: configuration,
 	}
 	apiResp, err := r.client.LinkItemToChannel(ctx, data.ItemName.Value, data.ChannelUid.Value, body)
 	if err != nil {
 		resp.Diagnostics.AddError("Create Link Error",
-			fmt.Sprintf("Unable to create link, got error: %s", err))
+			fmt.Sprintf("Unable to link item %s to channel %s, got error: %s", data.ItemName.Value, data.ChannelUid.Value, err))
 		return
 	}
 
 	if apiResp.StatusCode != 200 {
 		resp.Diagnostics.AddError("Create Link Error",
-			fmt.Sprintf("Unable to create link, got status: %s", apiResp.Status))
+			fmt.Sprintf("Unable to link item %s to channel %s, got error: %s", data.ItemName.Value, data.ChannelUid.Value, api.ReadResponseError(apiResp)))
 		return
 	}
 
 	// generate ID out of item name + channel uid
-	data.Id = types.String{Value: data.ItemName.Value + "-" + data.ChannelUid.Value}
+	data.Id = types.String{Value: generateLinkResourceId(data.ItemName.Value, data.ChannelUid.Value)}
+	data.ServerUuid = r.safeguard.serverUuidValue()
 
-	tflog.Trace(ctx, "created a Link resource", "item_name", data.ItemName.Value,
-		"channel_uid", data.ChannelUid.Value)
+	tflog.Trace(ctx, "created a Link resource", map[string]interface{}{"item_name": data.ItemName.Value,
+		"channel_uid": data.ChannelUid.Value})
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
@@ -132,23 +177,37 @@ func (r linkResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, r
 		return
 	}
 
+	diags = r.safeguard.checkServer("link", data.Id.Value, data.ServerUuid)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	apiResp, err := r.client.GetItemLink(ctx, data.ItemName.Value, data.ChannelUid.Value)
 	if err != nil {
 		resp.Diagnostics.AddError("Read Link Error",
-			fmt.Sprintf("Unable to read link, got error: %s", err))
+			fmt.Sprintf("Unable to read link of item %s to channel %s, got error: %s", data.ItemName.Value, data.ChannelUid.Value, err))
 		return
 	}
 
 	if apiResp.StatusCode == 404 {
-		tflog.Debug(ctx, "Link not found, will be removed from state", "item_name", data.ItemName.Value,
-			"channel_uid", data.ChannelUid.Value)
+		diags = r.safeguard.missing(ctx, "link", data.Id.Value)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		tflog.Debug(ctx, "Link not found, will be removed from state", map[string]interface{}{"item_name": data.ItemName.Value,
+			"channel_uid": data.ChannelUid.Value})
 
 		resp.State.RemoveResource(ctx)
 		return
 	}
 	if apiResp.StatusCode != 200 {
 		resp.Diagnostics.AddError("Read Link Error",
-			fmt.Sprintf("Unknown error reading link, got status: %s", apiResp.Status))
+			fmt.Sprintf("Unable to read link of item %s to channel %s, got error: %s", data.ItemName.Value, data.ChannelUid.Value, api.ReadResponseError(apiResp)))
 		return
 	}
 
@@ -156,12 +215,20 @@ func (r linkResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, r
 	err = api.ReadResponseBody(apiResp, apiRespObj)
 	if err != nil {
 		resp.Diagnostics.AddError("Read Link Error",
-			fmt.Sprintf("Unable to read response of read link action, got error: %s", err))
+			fmt.Sprintf("Unable to read response of reading link of item %s to channel %s, got error: %s", data.ItemName.Value, data.ChannelUid.Value, err))
 		return
 	}
 
+	r.safeguard.found("link")
+
 	// store enriched link to resource
-	enrichedItemChannelLinkToData(data, apiRespObj)
+	err = enrichedItemChannelLinkToData(&data, apiRespObj)
+	if err != nil {
+		resp.Diagnostics.AddError("Read Link Error",
+			fmt.Sprintf("Unable to convert link configuration, got error: %s", err))
+		return
+	}
+	data.ServerUuid = r.safeguard.keepServerUuid(data.ServerUuid)
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
@@ -177,7 +244,54 @@ func (r linkResource) Update(ctx context.Context, req tfsdk.UpdateResourceReques
 		return
 	}
 
-	resp.Diagnostics.AddError("Update Link Error", "Updating links is not supported")
+	// things and items may have been created in the same run, so they could not be validated during plan
+	profile, _ := linkProfile(data)
+	diags = validateLinkCompatibility(ctx, r.client, data.ItemName.Value, data.ChannelUid.Value, profile)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configuration, err := linkDataToConfiguration(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Update Link Error",
+			fmt.Sprintf("Unable to convert link configuration, got error: %s", err))
+		return
+	}
+
+	// linking is a PUT, so an existing link is overwritten including its configuration
+	body := api.LinkItemToChannelJSONRequestBody{
+		ItemName:   &data.ItemName.Value,
+		ChannelUID: &data.ChannelUid.Value,
+
+		Configuration: configuration,
+	}
+	apiResp, err := r.client.LinkItemToChannel(ctx, data.ItemName.Value, data.ChannelUid.Value, body)
+	if err != nil {
+		resp.Diagnostics.AddError("Update Link Error",
+			fmt.Sprintf("Unable to update link of item %s to channel %s, got error: %s", data.ItemName.Value, data.ChannelUid.Value, err))
+		return
+	}
+
+	if apiResp.StatusCode != 200 {
+		resp.Diagnostics.AddError("Update Link Error",
+			fmt.Sprintf("Unable to update link of item %s to channel %s, got error: %s", data.ItemName.Value, data.ChannelUid.Value, api.ReadResponseError(apiResp)))
+		return
+	}
+
+	data.Id = types.String{Value: generateLinkResourceId(data.ItemName.Value, data.ChannelUid.Value)}
+
+	var serverUuid types.String
+	diags = req.State.GetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("server_uuid"), &serverUuid)
+	resp.Diagnostics.Append(diags...)
+	data.ServerUuid = r.safeguard.keepServerUuid(serverUuid)
+
+	tflog.Trace(ctx, "updated a Link resource", map[string]interface{}{"item_name": data.ItemName.Value,
+		"channel_uid": data.ChannelUid.Value})
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r linkResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
@@ -193,16 +307,16 @@ func (r linkResource) Delete(ctx context.Context, req tfsdk.DeleteResourceReques
 	apiResp, err := r.client.UnlinkItemFromChannel(ctx, data.ItemName.Value, data.ChannelUid.Value)
 	if err != nil {
 		resp.Diagnostics.AddError("Delete Link Error",
-			fmt.Sprintf("Unable to delete link, got error: %s", err))
+			fmt.Sprintf("Unable to unlink item %s from channel %s, got error: %s", data.ItemName.Value, data.ChannelUid.Value, err))
 		return
 	}
 
 	if apiResp.StatusCode == 404 {
 		tflog.Debug(ctx, "Planned to remove a link, but it was already removed",
-			"item_name", data.ItemName.Value, "channel_uid", data.ChannelUid.Value)
+			map[string]interface{}{"item_name": data.ItemName.Value, "channel_uid": data.ChannelUid.Value})
 	} else if apiResp.StatusCode != 200 {
 		resp.Diagnostics.AddError("Delete Link Error",
-			fmt.Sprintf("Unable to delete link, got status: %s", apiResp.Status))
+			fmt.Sprintf("Unable to unlink item %s from channel %s, got error: %s", data.ItemName.Value, data.ChannelUid.Value, api.ReadResponseError(apiResp)))
 		return
 	}
 
@@ -210,18 +324,130 @@ func (r linkResource) Delete(ctx context.Context, req tfsdk.DeleteResourceReques
 }
 
 func (r linkResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
-	tfsdk.ResourceImportStatePassthroughID(ctx, tftypes.NewAttributePath().WithAttributeName("id"), req, resp)
+	itemName, channelUid, ok := parseLinkResourceId(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError("Import Link Error",
+			fmt.Sprintf("Unexpected import ID '%s', expected the format 'item_name-channel_uid'", req.ID))
+		return
+	}
+
+	diags := resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("id"), req.ID)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("item_name"), itemName)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("channel_uid"), channelUid)
+	resp.Diagnostics.Append(diags...)
 }
 
+// generateLinkResourceId joins item name and channel UID by a `-`, e.g. `test_item-modbus:data:meter:current`.
+// Item names never contain a `-`, so the ID can be split at the first `-` again, see parseLinkResourceId.
+// The format is also used as import ID and has to be kept stable.
 func generateLinkResourceId(itemName string, channelUid string) string {
 	return itemName + "-" + channelUid
 }
 
-func enrichedItemChannelLinkToData(data linkResourceData, apiRespObj *api.EnrichedItemChannelLinkDTO) {
+// parseLinkResourceId splits an ID created by generateLinkResourceId into item name and channel UID.
+func parseLinkResourceId(id string) (itemName string, channelUid string, ok bool) {
+	parts := strings.SplitN(id, "-", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", false
+	}
+
+	return parts[0], parts[1], true
+}
+
+func (r linkResource) ValidateConfig(ctx context.Context, req tfsdk.ValidateResourceConfigRequest, resp *tfsdk.ValidateResourceConfigResponse) {
+	var configuration types.Map
+	var configurationJson types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("configuration"), &configuration)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("configuration_json"), &configurationJson)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !configuration.Null && !configurationJson.Null {
+		resp.Diagnostics.AddAttributeError(tftypes.NewAttributePath().WithAttributeName("configuration_json"),
+			"Conflicting Link Configuration", "Only one of configuration and configuration_json can be set.")
+		return
+	}
+
+	if _, err := util.JsonToInterfaceMap(configurationJson); err != nil {
+		resp.Diagnostics.AddAttributeError(tftypes.NewAttributePath().WithAttributeName("configuration_json"),
+			"Invalid Link Configuration", fmt.Sprintf("Expected a JSON encoded object, got error: %s", err))
+	}
+}
+
+func (r linkResource) ModifyPlan(ctx context.Context, req tfsdk.ModifyResourcePlanRequest, resp *tfsdk.ModifyResourcePlanResponse) {
+	// nothing to validate on destroy or if nothing changed
+	if req.Plan.Raw.IsNull() || req.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+
+	var data linkResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() || data.ItemName.Unknown || data.ChannelUid.Unknown {
+		return
+	}
+
+	profile, known := linkProfile(data)
+	if !known {
+		return
+	}
+
+	diags = validateLinkCompatibility(ctx, r.client, data.ItemName.Value, data.ChannelUid.Value, profile)
+	resp.Diagnostics.Append(diags...)
+}
+
+// linkDataToConfiguration returns the configuration to send to openHAB, either the typed values of
+// configuration_json or the string values of configuration.
+func linkDataToConfiguration(data linkResourceData) (*map[string]interface{}, error) {
+	if !data.ConfigurationJson.Null {
+		return util.JsonToInterfaceMap(data.ConfigurationJson)
+	}
+
+	return util.TypeToInterfaceMap(data.Configuration), nil
+}
+
+// enrichedItemChannelLinkToData stores the link returned by openHAB in the given resource data. The configuration is
+// stored in the attribute that was used before and compared semantically to the prior value, so `"0"` and `0` do not
+// cause a difference. Without a prior value, e.g. after an import, configuration_json is only used if the
+// configuration contains non-string values.
+func enrichedItemChannelLinkToData(data *linkResourceData, apiRespObj *api.EnrichedItemChannelLinkDTO) error {
 	id := generateLinkResourceId(*apiRespObj.ItemName, *apiRespObj.ChannelUID)
 
 	data.Id = util.StringToType(&id)
 	data.ItemName = util.StringToType(apiRespObj.ItemName)
 	data.ChannelUid = util.StringToType(apiRespObj.ChannelUID)
-	data.Configuration = util.StringMapToType(apiRespObj.Configuration)
+
+	useJson := !data.ConfigurationJson.Null
+	if data.Configuration.Null && data.ConfigurationJson.Null && apiRespObj.Configuration != nil {
+		for _, v := range *apiRespObj.Configuration {
+			if _, ok := v.(string); !ok {
+				useJson = true
+				break
+			}
+		}
+	}
+
+	if !useJson {
+		data.Configuration = util.NormalizeInterfaceMapToType(data.Configuration, apiRespObj.Configuration)
+		data.ConfigurationJson = types.String{Null: true}
+		return nil
+	}
+
+	configurationJson, err := util.InterfaceMapToJson(apiRespObj.Configuration, data.ConfigurationJson)
+	if err != nil {
+		return err
+	}
+
+	data.Configuration = types.Map{ElemType: types.StringType, Null: true}
+	data.ConfigurationJson = configurationJson
+	return nil
 }
@@ -0,0 +1,358 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/chris922/terraform-provider-openhab/internal/api"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func testLinkRead(t *testing.T, prior linkResourceData, body string) linkResourceData {
+	ctx := context.Background()
+
+	schema, diags := LinkResourceType{}.GetSchema(ctx)
+	if diags.HasError() {
+		t.Fatalf("unable to get schema: %v", diags)
+	}
+
+	r := linkResource{client: testServer(t, "/links/test_item/modbus:data:meter:current", http.StatusOK, body)}
+
+	req := tfsdk.ReadResourceRequest{State: testState(t, schema, &prior)}
+	resp := &tfsdk.ReadResourceResponse{State: req.State}
+	r.Read(ctx, req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error reading link: %v", resp.Diagnostics)
+	}
+
+	var result linkResourceData
+	if diags := resp.State.Get(ctx, &result); diags.HasError() {
+		t.Fatalf("unable to get state: %v", diags)
+	}
+
+	return result
+}
+
+func TestLinkReadDetectsOutOfBandChanges(t *testing.T) {
+	prior := linkResourceData{
+		Id:         types.String{Value: generateLinkResourceId("test_item", "modbus:data:meter:current")},
+		ItemName:   types.String{Value: "test_item"},
+		ChannelUid: types.String{Value: "modbus:data:meter:current"},
+		Configuration: types.Map{ElemType: types.StringType, Elems: map[string]attr.Value{
+			"profile": types.String{Value: "modbus:gainOffset"},
+			"gain":    types.String{Value: "0.001 A"},
+		}},
+		ConfigurationJson: types.String{Null: true},
+	}
+
+	result := testLinkRead(t, prior, `{
+		"itemName": "test_item",
+		"channelUID": "modbus:data:meter:current",
+		"configuration": {"profile": "modbus:gainOffset", "gain": "0.01 A"}
+	}`)
+
+	if gain := result.Configuration.Elems["gain"].(types.String).Value; gain != "0.01 A" {
+		t.Errorf("expected configuration to be read from server, got %q", gain)
+	}
+}
+
+func TestLinkReadIgnoresEmptyConfiguration(t *testing.T) {
+	prior := linkResourceData{
+		Id:                types.String{Value: generateLinkResourceId("test_item", "modbus:data:meter:current")},
+		ItemName:          types.String{Value: "test_item"},
+		ChannelUid:        types.String{Value: "modbus:data:meter:current"},
+		Configuration:     types.Map{ElemType: types.StringType, Null: true},
+		ConfigurationJson: types.String{Null: true},
+	}
+
+	result := testLinkRead(t, prior, `{
+		"itemName": "test_item",
+		"channelUID": "modbus:data:meter:current",
+		"configuration": {}
+	}`)
+
+	if !result.Configuration.Null {
+		t.Errorf("expected empty configuration to stay null, got %v", result.Configuration)
+	}
+}
+
+func TestLinkReadComparesTypedConfigurationSemantically(t *testing.T) {
+	prior := linkResourceData{
+		Id:                types.String{Value: generateLinkResourceId("test_item", "modbus:data:meter:current")},
+		ItemName:          types.String{Value: "test_item"},
+		ChannelUid:        types.String{Value: "modbus:data:meter:current"},
+		Configuration:     types.Map{ElemType: types.StringType, Null: true},
+		ConfigurationJson: types.String{Value: `{"profile": "system:offset", "offset": "0", "invert": false}`},
+	}
+
+	result := testLinkRead(t, prior, `{
+		"itemName": "test_item",
+		"channelUID": "modbus:data:meter:current",
+		"configuration": {"profile": "system:offset", "offset": 0.0, "invert": false}
+	}`)
+
+	if result.ConfigurationJson.Value != prior.ConfigurationJson.Value {
+		t.Errorf("expected semantically equal configuration to keep prior value, got %q", result.ConfigurationJson.Value)
+	}
+
+	result = testLinkRead(t, prior, `{
+		"itemName": "test_item",
+		"channelUID": "modbus:data:meter:current",
+		"configuration": {"profile": "system:offset", "offset": 2, "invert": false}
+	}`)
+
+	if result.ConfigurationJson.Value != `{"invert":false,"offset":2,"profile":"system:offset"}` {
+		t.Errorf("expected changed configuration to be read from server, got %q", result.ConfigurationJson.Value)
+	}
+}
+
+func TestLinkUpdateOverwritesConfiguration(t *testing.T) {
+	ctx := context.Background()
+
+	schema, diags := LinkResourceType{}.GetSchema(ctx)
+	if diags.HasError() {
+		t.Fatalf("unable to get schema: %v", diags)
+	}
+
+	var method string
+	var body map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.URL.Path, "/links/") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		method = r.Method
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := api.NewClient(server.URL)
+	if err != nil {
+		t.Fatalf("unable to create client: %s", err)
+	}
+	r := linkResource{client: client}
+
+	planned := linkResourceData{
+		Id:         types.String{Value: generateLinkResourceId("test_item", "modbus:data:meter:current")},
+		ItemName:   types.String{Value: "test_item"},
+		ChannelUid: types.String{Value: "modbus:data:meter:current"},
+		Configuration: types.Map{ElemType: types.StringType, Elems: map[string]attr.Value{
+			"profile": types.String{Value: "modbus:gainOffset"},
+			"gain":    types.String{Value: "0.01 A"},
+		}},
+		ConfigurationJson: types.String{Null: true},
+	}
+	plannedState := testState(t, schema, &planned)
+
+	req := tfsdk.UpdateResourceRequest{
+		Config: tfsdk.Config{Schema: schema, Raw: plannedState.Raw},
+		Plan:   tfsdk.Plan{Schema: schema, Raw: plannedState.Raw},
+		State:  tfsdk.State{Schema: schema, Raw: plannedState.Raw},
+	}
+	resp := &tfsdk.UpdateResourceResponse{State: tfsdk.State{Schema: schema, Raw: plannedState.Raw}}
+	r.Update(ctx, req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error updating link: %v", resp.Diagnostics)
+	}
+	if method != http.MethodPut {
+		t.Errorf("expected link to be updated using PUT, got %s", method)
+	}
+	if configuration, ok := body["configuration"].(map[string]interface{}); !ok || configuration["gain"] != "0.01 A" {
+		t.Errorf("expected updated configuration to be sent, got %v", body["configuration"])
+	}
+}
+
+func TestLinkImportReadsAllAttributes(t *testing.T) {
+	ctx := context.Background()
+
+	schema, diags := LinkResourceType{}.GetSchema(ctx)
+	if diags.HasError() {
+		t.Fatalf("unable to get schema: %v", diags)
+	}
+
+	r := linkResource{client: testServer(t, "/links/test_item/modbus:data:meter:current-l1", http.StatusOK, `{
+		"itemName": "test_item",
+		"channelUID": "modbus:data:meter:current-l1",
+		"configuration": {"profile": "system:offset", "offset": "1"}
+	}`)}
+
+	importReq := tfsdk.ImportResourceStateRequest{ID: "test_item-modbus:data:meter:current-l1"}
+	importResp := &tfsdk.ImportResourceStateResponse{State: tfsdk.State{
+		Schema: schema,
+		Raw:    tftypes.NewValue(schema.TerraformType(ctx), nil),
+	}}
+	r.ImportState(ctx, importReq, importResp)
+
+	if importResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error importing link: %v", importResp.Diagnostics)
+	}
+
+	req := tfsdk.ReadResourceRequest{State: importResp.State}
+	resp := &tfsdk.ReadResourceResponse{State: req.State}
+	r.Read(ctx, req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error reading link: %v", resp.Diagnostics)
+	}
+
+	var result linkResourceData
+	if diags := resp.State.Get(ctx, &result); diags.HasError() {
+		t.Fatalf("unable to get state: %v", diags)
+	}
+
+	if result.Id.Value != importReq.ID {
+		t.Errorf("expected ID %q, got %q", importReq.ID, result.Id.Value)
+	}
+	if result.ItemName.Value != "test_item" || result.ChannelUid.Value != "modbus:data:meter:current-l1" {
+		t.Errorf("expected item name and channel UID to be split at the first dash, got %q and %q",
+			result.ItemName.Value, result.ChannelUid.Value)
+	}
+	if offset := result.Configuration.Elems["offset"].(types.String).Value; offset != "1" {
+		t.Errorf("expected configuration to be read from server, got %q", offset)
+	}
+}
+
+func TestLinkImportUsesJsonForTypedConfiguration(t *testing.T) {
+	result := testLinkRead(t, linkResourceData{
+		Id:                types.String{Value: generateLinkResourceId("test_item", "modbus:data:meter:current")},
+		ItemName:          types.String{Value: "test_item"},
+		ChannelUid:        types.String{Value: "modbus:data:meter:current"},
+		Configuration:     types.Map{ElemType: types.StringType, Null: true},
+		ConfigurationJson: types.String{Null: true},
+	}, `{
+		"itemName": "test_item",
+		"channelUID": "modbus:data:meter:current",
+		"configuration": {"profile": "system:hysteresis", "lower": 10, "upper": 20}
+	}`)
+
+	if !result.Configuration.Null {
+		t.Errorf("expected configuration to stay null for typed values, got %v", result.Configuration)
+	}
+	if result.ConfigurationJson.Value != `{"lower":10,"profile":"system:hysteresis","upper":20}` {
+		t.Errorf("expected typed configuration to be stored as JSON, got %q", result.ConfigurationJson.Value)
+	}
+}
+
+func TestLinkImportRejectsInvalidId(t *testing.T) {
+	for _, id := range []string{"test_item", "-modbus:data:meter:current", "test_item-"} {
+		if _, _, ok := parseLinkResourceId(id); ok {
+			t.Errorf("expected ID %q to be rejected", id)
+		}
+	}
+}
+
+// testRoutes starts a server answering requests to the given paths with the related body, other paths return a 404.
+func testRoutes(t *testing.T, routes map[string]string) *api.Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := routes[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := api.NewClient(server.URL)
+	if err != nil {
+		t.Fatalf("unable to create client: %s", err)
+	}
+
+	return client
+}
+
+func testLinkRoutes(itemType string, channelItemType string, kind string) map[string]string {
+	return map[string]string{
+		"/items/test_item": `{"name": "test_item", "type": "` + itemType + `"}`,
+		"/things/modbus:data:meter": `{"UID": "modbus:data:meter", "channels": [{
+			"uid": "modbus:data:meter:current",
+			"channelTypeUID": "modbus:number-type",
+			"itemType": "` + channelItemType + `",
+			"kind": "` + kind + `"
+		}]}`,
+		"/channel-types/modbus:number-type/linkableItemTypes": `["Switch", "String"]`,
+		"/profile-types": `[
+			{"uid": "system:rawbutton-toggle-switch", "kind": "TRIGGER"},
+			{"uid": "system:default", "kind": "STATE"}
+		]`,
+	}
+}
+
+func TestLinkValidationChecksItemType(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		itemType        string
+		channelItemType string
+		profile         string
+		valid           bool
+	}{
+		{itemType: "Number:Power", channelItemType: "Number:Power", valid: true},
+		{itemType: "Number", channelItemType: "Number:Power", valid: true},
+		{itemType: "Dimmer", channelItemType: "Switch", valid: true},
+		{itemType: "Number:Power", channelItemType: "Number:Energy", valid: false},
+		{itemType: "Switch", channelItemType: "Number", valid: false},
+		{itemType: "Switch", channelItemType: "Number", profile: "system:default", valid: false},
+		{itemType: "String", channelItemType: "Number", profile: "transform:MAP", valid: true},
+	}
+
+	for _, test := range tests {
+		client := testRoutes(t, testLinkRoutes(test.itemType, test.channelItemType, "STATE"))
+		diags := validateLinkCompatibility(ctx, client, "test_item", "modbus:data:meter:current", test.profile)
+
+		if diags.HasError() == test.valid {
+			t.Errorf("expected item type %s with channel item type %s and profile %q to be valid=%t, got %v",
+				test.itemType, test.channelItemType, test.profile, test.valid, diags)
+		}
+	}
+}
+
+func TestLinkValidationChecksTriggerProfile(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		itemType string
+		profile  string
+		valid    bool
+	}{
+		{itemType: "Switch", profile: "system:rawbutton-toggle-switch", valid: true},
+		{itemType: "Switch", profile: "", valid: false},
+		{itemType: "Switch", profile: "system:default", valid: false},
+		{itemType: "Number", profile: "system:rawbutton-toggle-switch", valid: false},
+	}
+
+	for _, test := range tests {
+		client := testRoutes(t, testLinkRoutes(test.itemType, "", "TRIGGER"))
+		diags := validateLinkCompatibility(ctx, client, "test_item", "modbus:data:meter:current", test.profile)
+
+		if diags.HasError() == test.valid {
+			t.Errorf("expected item type %s with trigger profile %q to be valid=%t, got %v",
+				test.itemType, test.profile, test.valid, diags)
+		}
+	}
+}
+
+func TestLinkValidationSkipsMissingThing(t *testing.T) {
+	routes := testLinkRoutes("Switch", "Number", "STATE")
+	delete(routes, "/things/modbus:data:meter")
+
+	diags := validateLinkCompatibility(context.Background(), testRoutes(t, routes), "test_item",
+		"modbus:data:meter:current", "")
+	if diags.HasError() {
+		t.Errorf("expected validation of a missing thing to be skipped, got %v", diags)
+	}
+}
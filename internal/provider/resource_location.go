@@ -0,0 +1,23 @@
+package provider
+
+import (
+	"context"
+	"github.com/chris922/terraform-provider-openhab/internal/provider/validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+var locationKind = semanticGroupKind{
+	class:       validator.SemanticLocation,
+	typeExample: "LivingRoom",
+}
+
+type LocationResourceType struct{}
+
+func (t LocationResourceType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return semanticGroupSchema(locationKind), nil
+}
+
+func (t LocationResourceType) NewResource(_ context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return newSemanticGroupResource(locationKind, in)
+}
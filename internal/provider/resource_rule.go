@@ -0,0 +1,591 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/chris922/terraform-provider-openhab/internal/api"
+	"github.com/chris922/terraform-provider-openhab/internal/provider/util"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type RuleResourceType struct{}
+
+func ruleModuleAttributes(withInputs bool) map[string]tfsdk.Attribute {
+	attributes := map[string]tfsdk.Attribute{
+		"id": {
+			MarkdownDescription: "Module ID, unique within the rule",
+			Required:            true,
+			Type:                types.StringType,
+		},
+		"type": {
+			MarkdownDescription: "Module type UID, e.g. `core.ItemStateChangeTrigger`",
+			Required:            true,
+			Type:                types.StringType,
+		},
+		"label": {
+			MarkdownDescription: "Module label",
+			Optional:            true,
+			Type:                types.StringType,
+		},
+		"description": {
+			MarkdownDescription: "Module description",
+			Optional:            true,
+			Type:                types.StringType,
+		},
+		"configuration": {
+			MarkdownDescription: "Module configuration as JSON encoded object, use `jsonencode` to support nested values",
+			Optional:            true,
+			Type:                types.StringType,
+		},
+	}
+
+	if withInputs {
+		attributes["inputs"] = tfsdk.Attribute{
+			MarkdownDescription: "Module inputs, mapping input names to outputs of other modules",
+			Optional:            true,
+			Type:                types.MapType{ElemType: types.StringType},
+		}
+	}
+
+	return attributes
+}
+
+func (t RuleResourceType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "OpenHAB Rule",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				MarkdownDescription: "Resource ID",
+				Computed:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"uid": {
+				MarkdownDescription: "Rule UID",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"name": {
+				MarkdownDescription: "Rule name",
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+			"description": {
+				MarkdownDescription: "Rule description",
+				Optional:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+			"tags": {
+				MarkdownDescription: "Rule tags",
+				Optional:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+				Type: types.ListType{ElemType: types.StringType},
+			},
+			"visibility": {
+				MarkdownDescription: "Rule visibility, one of `VISIBLE`, `HIDDEN` or `EXPERT`",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+			"configuration": {
+				MarkdownDescription: "Rule configuration as JSON encoded object, use `jsonencode` to support nested values",
+				Optional:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+		},
+
+		Blocks: map[string]tfsdk.Block{
+			"triggers": {
+				MarkdownDescription: "Triggers starting the rule",
+				NestingMode:         tfsdk.BlockNestingModeList,
+				Attributes:          ruleModuleAttributes(false),
+			},
+			"conditions": {
+				MarkdownDescription: "Conditions that must be satisfied to execute the actions",
+				NestingMode:         tfsdk.BlockNestingModeList,
+				Attributes:          ruleModuleAttributes(true),
+			},
+			"actions": {
+				MarkdownDescription: "Actions executed by the rule",
+				NestingMode:         tfsdk.BlockNestingModeList,
+				Attributes:          ruleModuleAttributes(true),
+			},
+		},
+	}, nil
+}
+
+func (t RuleResourceType) NewResource(_ context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := ConvertProviderType(in)
+
+	return ruleResource{
+		client: provider.Client,
+	}, diags
+}
+
+type ruleResourceData struct {
+	Id types.String `tfsdk:"id"`
+
+	// required
+	Uid  types.String `tfsdk:"uid"`
+	Name types.String `tfsdk:"name"`
+
+	// optional
+	Description   types.String `tfsdk:"description"`
+	Tags          types.List   `tfsdk:"tags"`
+	Visibility    types.String `tfsdk:"visibility"`
+	Configuration types.String `tfsdk:"configuration"`
+
+	// blocks
+	Triggers   []ruleTriggerData `tfsdk:"triggers"`
+	Conditions []ruleModuleData  `tfsdk:"conditions"`
+	Actions    []ruleModuleData  `tfsdk:"actions"`
+}
+
+type ruleTriggerData struct {
+	// required
+	Id   types.String `tfsdk:"id"`
+	Type types.String `tfsdk:"type"`
+
+	// optional
+	Label         types.String `tfsdk:"label"`
+	Description   types.String `tfsdk:"description"`
+	Configuration types.String `tfsdk:"configuration"`
+}
+
+type ruleModuleData struct {
+	// required
+	Id   types.String `tfsdk:"id"`
+	Type types.String `tfsdk:"type"`
+
+	// optional
+	Label         types.String `tfsdk:"label"`
+	Description   types.String `tfsdk:"description"`
+	Configuration types.String `tfsdk:"configuration"`
+	Inputs        types.Map    `tfsdk:"inputs"`
+}
+
+type ruleResource struct {
+	client *api.Client
+}
+
+func (r ruleResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data ruleResourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, err := ruleDataToDTO(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Rule Error",
+			fmt.Sprintf("Unable to convert rule configuration, got error: %s", err))
+		return
+	}
+
+	apiResp, err := r.client.CreateRule(ctx, api.CreateRuleJSONRequestBody(body))
+	if err != nil {
+		resp.Diagnostics.AddError("Create Rule Error",
+			fmt.Sprintf("Unable to create rule %s, got error: %s", data.Uid.Value, err))
+		return
+	}
+
+	if apiResp.StatusCode == 409 {
+		resp.Diagnostics.AddError("Create Rule Error",
+			fmt.Sprintf("Rule %s already exists, import it instead", data.Uid.Value))
+		return
+	} else if apiResp.StatusCode != 201 {
+		resp.Diagnostics.AddError("Create Rule Error",
+			fmt.Sprintf("Unable to create rule %s, got error: %s", data.Uid.Value, api.ReadResponseError(apiResp)))
+		return
+	}
+
+	// openHAB does not return the created rule, so it has to be read afterwards
+	apiRespObj, diags := r.getRule(ctx, data.Uid.Value)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// store enriched rule to resource
+	diags = enrichedRuleToData(&data, apiRespObj)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Trace(ctx, "created a Rule resource", map[string]interface{}{"uid": data.Uid.Value})
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r ruleResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data ruleResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, err := r.client.GetRuleById(ctx, data.Uid.Value)
+	if err != nil {
+		resp.Diagnostics.AddError("Read Rule Error",
+			fmt.Sprintf("Unable to read rule %s, got error: %s", data.Uid.Value, err))
+		return
+	}
+
+	if apiResp.StatusCode == 404 {
+		tflog.Debug(ctx, "Rule not found, will be removed from state", map[string]interface{}{"uid": data.Uid.Value})
+
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if apiResp.StatusCode != 200 {
+		resp.Diagnostics.AddError("Read Rule Error",
+			fmt.Sprintf("Unable to read rule %s, got error: %s", data.Uid.Value, api.ReadResponseError(apiResp)))
+		return
+	}
+
+	apiRespObj := &api.EnrichedRuleDTO{}
+	err = api.ReadResponseBody(apiResp, apiRespObj)
+	if err != nil {
+		resp.Diagnostics.AddError("Read Rule Error",
+			fmt.Sprintf("Unable to read response of reading rule %s, got error: %s", data.Uid.Value, err))
+		return
+	}
+
+	// store enriched rule to resource
+	diags = enrichedRuleToData(&data, apiRespObj)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r ruleResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var data ruleResourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, err := ruleDataToDTO(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Update Rule Error",
+			fmt.Sprintf("Unable to convert rule configuration, got error: %s", err))
+		return
+	}
+
+	apiResp, err := r.client.UpdateRule(ctx, data.Uid.Value, api.UpdateRuleJSONRequestBody(body))
+	if err != nil {
+		resp.Diagnostics.AddError("Update Rule Error",
+			fmt.Sprintf("Unable to update rule %s, got error: %s", data.Uid.Value, err))
+		return
+	}
+
+	if apiResp.StatusCode != 200 {
+		resp.Diagnostics.AddError("Update Rule Error",
+			fmt.Sprintf("Unable to update rule %s, got error: %s", data.Uid.Value, api.ReadResponseError(apiResp)))
+		return
+	}
+
+	// openHAB does not return the updated rule, so it has to be read afterwards
+	apiRespObj, diags := r.getRule(ctx, data.Uid.Value)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// store enriched rule to resource
+	diags = enrichedRuleToData(&data, apiRespObj)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Trace(ctx, "updated a Rule resource", map[string]interface{}{"uid": data.Uid.Value})
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r ruleResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var data ruleResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, err := r.client.DeleteRule(ctx, data.Uid.Value)
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Rule Error",
+			fmt.Sprintf("Unable to delete rule %s, got error: %s", data.Uid.Value, err))
+		return
+	}
+
+	if apiResp.StatusCode == 404 {
+		tflog.Debug(ctx, "Planned to remove a rule, but it was already removed", map[string]interface{}{"uid": data.Uid.Value})
+	} else if apiResp.StatusCode != 200 {
+		resp.Diagnostics.AddError("Delete Rule Error",
+			fmt.Sprintf("Unable to delete rule %s, got error: %s", data.Uid.Value, api.ReadResponseError(apiResp)))
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r ruleResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	tfsdk.ResourceImportStatePassthroughID(ctx, tftypes.NewAttributePath().WithAttributeName("uid"), req, resp)
+}
+
+func (r ruleResource) getRule(ctx context.Context, uid string) (*api.EnrichedRuleDTO, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	apiResp, err := r.client.GetRuleById(ctx, uid)
+	if err != nil {
+		diags.AddError("Read Rule Error",
+			fmt.Sprintf("Unable to read rule %s, got error: %s", uid, err))
+		return nil, diags
+	}
+
+	if apiResp.StatusCode != 200 {
+		diags.AddError("Read Rule Error",
+			fmt.Sprintf("Unable to read rule %s, got error: %s", uid, api.ReadResponseError(apiResp)))
+		return nil, diags
+	}
+
+	apiRespObj := &api.EnrichedRuleDTO{}
+	err = api.ReadResponseBody(apiResp, apiRespObj)
+	if err != nil {
+		diags.AddError("Read Rule Error",
+			fmt.Sprintf("Unable to read response of reading rule %s, got error: %s", uid, err))
+		return nil, diags
+	}
+
+	return apiRespObj, diags
+}
+
+func ruleDataToDTO(data ruleResourceData) (api.RuleDTO, error) {
+	configuration, err := util.JsonToInterfaceMap(data.Configuration)
+	if err != nil {
+		return api.RuleDTO{}, fmt.Errorf("rule configuration: %w", err)
+	}
+
+	triggers := make([]api.TriggerDTO, 0, len(data.Triggers))
+	for _, trigger := range data.Triggers {
+		triggerConfiguration, err := util.JsonToInterfaceMap(trigger.Configuration)
+		if err != nil {
+			return api.RuleDTO{}, fmt.Errorf("configuration of trigger %s: %w", trigger.Id.Value, err)
+		}
+
+		triggers = append(triggers, api.TriggerDTO{
+			Id:            util.TypeToString(trigger.Id),
+			Type:          util.TypeToString(trigger.Type),
+			Label:         util.TypeToString(trigger.Label),
+			Description:   util.TypeToString(trigger.Description),
+			Configuration: triggerConfiguration,
+		})
+	}
+
+	conditions := make([]api.ConditionDTO, 0, len(data.Conditions))
+	for _, condition := range data.Conditions {
+		conditionConfiguration, err := util.JsonToInterfaceMap(condition.Configuration)
+		if err != nil {
+			return api.RuleDTO{}, fmt.Errorf("configuration of condition %s: %w", condition.Id.Value, err)
+		}
+
+		var inputs *api.ConditionDTO_Inputs
+		if inputsMap := util.TypeToStringMap(condition.Inputs); inputsMap != nil {
+			inputs = &api.ConditionDTO_Inputs{AdditionalProperties: *inputsMap}
+		}
+
+		conditions = append(conditions, api.ConditionDTO{
+			Id:            util.TypeToString(condition.Id),
+			Type:          util.TypeToString(condition.Type),
+			Label:         util.TypeToString(condition.Label),
+			Description:   util.TypeToString(condition.Description),
+			Configuration: conditionConfiguration,
+			Inputs:        inputs,
+		})
+	}
+
+	actions := make([]api.ActionDTO, 0, len(data.Actions))
+	for _, action := range data.Actions {
+		actionConfiguration, err := util.JsonToInterfaceMap(action.Configuration)
+		if err != nil {
+			return api.RuleDTO{}, fmt.Errorf("configuration of action %s: %w", action.Id.Value, err)
+		}
+
+		var inputs *api.ActionDTO_Inputs
+		if inputsMap := util.TypeToStringMap(action.Inputs); inputsMap != nil {
+			inputs = &api.ActionDTO_Inputs{AdditionalProperties: *inputsMap}
+		}
+
+		actions = append(actions, api.ActionDTO{
+			Id:            util.TypeToString(action.Id),
+			Type:          util.TypeToString(action.Type),
+			Label:         util.TypeToString(action.Label),
+			Description:   util.TypeToString(action.Description),
+			Configuration: actionConfiguration,
+			Inputs:        inputs,
+		})
+	}
+
+	var visibility *api.RuleDTOVisibility
+	if v := util.TypeToString(data.Visibility); v != nil {
+		vTyped := api.RuleDTOVisibility(*v)
+		visibility = &vTyped
+	}
+
+	return api.RuleDTO{
+		Uid:  util.TypeToString(data.Uid),
+		Name: util.TypeToString(data.Name),
+
+		Description:   util.TypeToString(data.Description),
+		Tags:          util.TypeToStringArray(data.Tags),
+		Visibility:    visibility,
+		Configuration: configuration,
+
+		Triggers:   &triggers,
+		Conditions: &conditions,
+		Actions:    &actions,
+	}, nil
+}
+
+func enrichedRuleToData(data *ruleResourceData, apiRespObj *api.EnrichedRuleDTO) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var err error
+
+	data.Id = util.StringToType(apiRespObj.Uid)
+	data.Uid = util.StringToType(apiRespObj.Uid)
+	data.Name = util.StringToType(apiRespObj.Name)
+
+	data.Description = util.StringToType(apiRespObj.Description)
+	// openHAB stores rule tags as a set, so their order is not kept
+	data.Tags = util.NormalizeStringArrayToType(data.Tags, apiRespObj.Tags)
+	if apiRespObj.Visibility != nil {
+		visibility := string(*apiRespObj.Visibility)
+		data.Visibility = util.StringToType(&visibility)
+	} else {
+		data.Visibility = types.String{Null: true}
+	}
+
+	data.Configuration, err = util.InterfaceMapToJson(apiRespObj.Configuration, data.Configuration)
+	if err != nil {
+		diags.AddError("Rule Conversion Error",
+			fmt.Sprintf("Unable to convert rule configuration, got error: %s", err))
+	}
+
+	// prior module configurations are used to keep their formatting, see util.InterfaceMapToJson
+	priorConfigurations := make(map[string]types.String)
+	for _, trigger := range data.Triggers {
+		priorConfigurations["trigger:"+trigger.Id.Value] = trigger.Configuration
+	}
+	for _, condition := range data.Conditions {
+		priorConfigurations["condition:"+condition.Id.Value] = condition.Configuration
+	}
+	for _, action := range data.Actions {
+		priorConfigurations["action:"+action.Id.Value] = action.Configuration
+	}
+	moduleConfiguration := func(key string, v *map[string]interface{}) types.String {
+		prior, ok := priorConfigurations[key]
+		if !ok {
+			prior = types.String{Null: true}
+		}
+
+		configuration, err := util.InterfaceMapToJson(v, prior)
+		if err != nil {
+			diags.AddError("Rule Conversion Error",
+				fmt.Sprintf("Unable to convert configuration of module %s, got error: %s", key, err))
+		}
+
+		return configuration
+	}
+
+	// blocks are never null, so always use an empty list
+	data.Triggers = []ruleTriggerData{}
+	if apiRespObj.Triggers != nil {
+		for _, trigger := range *apiRespObj.Triggers {
+			data.Triggers = append(data.Triggers, ruleTriggerData{
+				Id:            util.StringToType(trigger.Id),
+				Type:          util.StringToType(trigger.Type),
+				Label:         util.StringToType(trigger.Label),
+				Description:   util.StringToType(trigger.Description),
+				Configuration: moduleConfiguration("trigger:"+util.StringToType(trigger.Id).Value, trigger.Configuration),
+			})
+		}
+	}
+
+	data.Conditions = []ruleModuleData{}
+	if apiRespObj.Conditions != nil {
+		for _, condition := range *apiRespObj.Conditions {
+			var inputs *map[string]string
+			if condition.Inputs != nil && len(condition.Inputs.AdditionalProperties) > 0 {
+				inputs = &condition.Inputs.AdditionalProperties
+			}
+
+			data.Conditions = append(data.Conditions, ruleModuleData{
+				Id:            util.StringToType(condition.Id),
+				Type:          util.StringToType(condition.Type),
+				Label:         util.StringToType(condition.Label),
+				Description:   util.StringToType(condition.Description),
+				Configuration: moduleConfiguration("condition:"+util.StringToType(condition.Id).Value, condition.Configuration),
+				Inputs:        util.StringMapToType(inputs),
+			})
+		}
+	}
+
+	data.Actions = []ruleModuleData{}
+	if apiRespObj.Actions != nil {
+		for _, action := range *apiRespObj.Actions {
+			var inputs *map[string]string
+			if action.Inputs != nil && len(action.Inputs.AdditionalProperties) > 0 {
+				inputs = &action.Inputs.AdditionalProperties
+			}
+
+			data.Actions = append(data.Actions, ruleModuleData{
+				Id:            util.StringToType(action.Id),
+				Type:          util.StringToType(action.Type),
+				Label:         util.StringToType(action.Label),
+				Description:   util.StringToType(action.Description),
+				Configuration: moduleConfiguration("action:"+util.StringToType(action.Id).Value, action.Configuration),
+				Inputs:        util.StringMapToType(inputs),
+			})
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,240 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/chris922/terraform-provider-openhab/internal/api"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testRuleData() ruleResourceData {
+	return ruleResourceData{
+		Id:            types.String{Value: "test_rule"},
+		Uid:           types.String{Value: "test_rule"},
+		Name:          types.String{Value: "Test Rule"},
+		Description:   types.String{Null: true},
+		Tags:          testStringList("light", "evening"),
+		Visibility:    types.String{Value: "VISIBLE"},
+		Configuration: types.String{Null: true},
+		Triggers: []ruleTriggerData{
+			{
+				Id:            types.String{Value: "1"},
+				Type:          types.String{Value: "timer.GenericCronTrigger"},
+				Label:         types.String{Null: true},
+				Description:   types.String{Null: true},
+				Configuration: types.String{Value: `{"cronExpression": "0 0 18 * * ? *"}`},
+			},
+		},
+		Conditions: []ruleModuleData{
+			{
+				Id:            types.String{Value: "2"},
+				Type:          types.String{Value: "core.ItemStateCondition"},
+				Label:         types.String{Null: true},
+				Description:   types.String{Null: true},
+				Configuration: types.String{Value: `{"itemName": "Presence", "operator": "=", "state": "ON"}`},
+				Inputs:        types.Map{ElemType: types.StringType, Null: true},
+			},
+		},
+		Actions: []ruleModuleData{
+			{
+				Id:            types.String{Value: "3"},
+				Type:          types.String{Value: "core.ItemCommandAction"},
+				Label:         types.String{Value: "Switch on"},
+				Description:   types.String{Null: true},
+				Configuration: types.String{Value: `{"itemName": "Light", "command": "ON", "delay": {"seconds": 5}}`},
+				Inputs:        testStringMap(map[string]string{"event": "1.event"}),
+			},
+		},
+	}
+}
+
+// testRuleRoundTrip converts the rule data into a DTO and reads it back like a rule returned by openHAB.
+func testRuleRoundTrip(t *testing.T, data ruleResourceData, modify func(*api.EnrichedRuleDTO)) ruleResourceData {
+	dto, err := ruleDataToDTO(data)
+	if err != nil {
+		t.Fatalf("unable to convert rule: %s", err)
+	}
+
+	body, err := json.Marshal(dto)
+	if err != nil {
+		t.Fatalf("unable to marshal rule: %s", err)
+	}
+	enriched := &api.EnrichedRuleDTO{}
+	if err := json.Unmarshal(body, enriched); err != nil {
+		t.Fatalf("unable to unmarshal rule: %s", err)
+	}
+	if modify != nil {
+		modify(enriched)
+	}
+
+	result := data
+	if diags := enrichedRuleToData(&result, enriched); diags.HasError() {
+		t.Fatalf("unable to convert rule: %v", diags)
+	}
+
+	return result
+}
+
+func TestRuleDataToDTO(t *testing.T) {
+	dto, err := ruleDataToDTO(testRuleData())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if dto.Configuration != nil {
+		t.Errorf("expected missing rule configuration, got %v", *dto.Configuration)
+	}
+	if len(*dto.Triggers) != 1 || len(*dto.Conditions) != 1 || len(*dto.Actions) != 1 {
+		t.Fatalf("expected a single trigger, condition and action, got %v", dto)
+	}
+
+	delay, ok := (*(*dto.Actions)[0].Configuration)["delay"].(map[string]interface{})
+	if !ok || delay["seconds"] != 5.0 {
+		t.Errorf("expected nested action configuration to be decoded, got %v", *(*dto.Actions)[0].Configuration)
+	}
+	if event := (*dto.Actions)[0].Inputs.AdditionalProperties["event"]; event != "1.event" {
+		t.Errorf("expected action input, got %q", event)
+	}
+	if (*dto.Conditions)[0].Inputs != nil {
+		t.Errorf("expected missing condition inputs, got %v", (*dto.Conditions)[0].Inputs)
+	}
+	if tags := *dto.Tags; len(tags) != 2 || tags[0] != "light" || tags[1] != "evening" {
+		t.Errorf("expected tags, got %v", tags)
+	}
+}
+
+func TestRuleDataToDTORejectsInvalidConfiguration(t *testing.T) {
+	data := testRuleData()
+	data.Conditions[0].Configuration = types.String{Value: `{"itemName": }`}
+
+	if _, err := ruleDataToDTO(data); err == nil {
+		t.Errorf("expected error for invalid condition configuration")
+	}
+
+	data = testRuleData()
+	data.Configuration = types.String{Value: `["not", "an", "object"]`}
+
+	if _, err := ruleDataToDTO(data); err == nil {
+		t.Errorf("expected error for rule configuration that is not an object")
+	}
+}
+
+func TestRuleReadKeepsModuleConfiguration(t *testing.T) {
+	data := testRuleData()
+
+	// openHAB normalizes configuration values based on the config description of the module type
+	result := testRuleRoundTrip(t, data, func(rule *api.EnrichedRuleDTO) {
+		(*(*rule.Actions)[0].Configuration)["delay"] = map[string]interface{}{"seconds": "5"}
+	})
+
+	for i := range data.Triggers {
+		if !result.Triggers[i].Configuration.Equal(data.Triggers[i].Configuration) {
+			t.Errorf("expected trigger configuration %v, got %v", data.Triggers[i].Configuration,
+				result.Triggers[i].Configuration)
+		}
+	}
+	if !result.Conditions[0].Configuration.Equal(data.Conditions[0].Configuration) {
+		t.Errorf("expected condition configuration %v, got %v", data.Conditions[0].Configuration,
+			result.Conditions[0].Configuration)
+	}
+	if !result.Actions[0].Configuration.Equal(data.Actions[0].Configuration) {
+		t.Errorf("expected action configuration %v, got %v", data.Actions[0].Configuration,
+			result.Actions[0].Configuration)
+	}
+	if !result.Actions[0].Inputs.Equal(data.Actions[0].Inputs) || !result.Conditions[0].Inputs.Null {
+		t.Errorf("expected module inputs to be kept, got %v and %v", result.Actions[0].Inputs,
+			result.Conditions[0].Inputs)
+	}
+
+	result = testRuleRoundTrip(t, data, func(rule *api.EnrichedRuleDTO) {
+		(*(*rule.Actions)[0].Configuration)["command"] = "OFF"
+	})
+	if result.Actions[0].Configuration.Value != `{"command":"OFF","delay":{"seconds":5},"itemName":"Light"}` {
+		t.Errorf("expected changed action configuration, got %v", result.Actions[0].Configuration)
+	}
+}
+
+func TestRuleReadKeepsTagOrder(t *testing.T) {
+	data := testRuleData()
+
+	result := testRuleRoundTrip(t, data, func(rule *api.EnrichedRuleDTO) {
+		rule.Tags = &[]string{"evening", "light"}
+	})
+	if !result.Tags.Equal(data.Tags) {
+		t.Errorf("expected tags in prior order, got %v", result.Tags)
+	}
+
+	result = testRuleRoundTrip(t, data, func(rule *api.EnrichedRuleDTO) {
+		rule.Tags = &[]string{"evening", "morning"}
+	})
+	if !result.Tags.Equal(testStringList("evening", "morning")) {
+		t.Errorf("expected changed tags, got %v", result.Tags)
+	}
+}
+
+func TestRuleReadNullAndEmptyValues(t *testing.T) {
+	tests := map[string]struct {
+		tags     types.List
+		response *[]string
+		expected types.List
+	}{
+		"null tags, none returned": {
+			tags:     types.List{ElemType: types.StringType, Null: true},
+			response: nil,
+			expected: types.List{ElemType: types.StringType, Null: true},
+		},
+		"null tags, empty returned": {
+			tags:     types.List{ElemType: types.StringType, Null: true},
+			response: &[]string{},
+			expected: types.List{ElemType: types.StringType, Null: true},
+		},
+		"empty tags": {
+			tags:     testStringList(),
+			response: &[]string{},
+			expected: testStringList(),
+		},
+		"empty tags, none returned": {
+			tags:     testStringList(),
+			response: nil,
+			expected: testStringList(),
+		},
+	}
+
+	for name, test := range tests {
+		data := testRuleData()
+		data.Tags = test.tags
+
+		result := testRuleRoundTrip(t, data, func(rule *api.EnrichedRuleDTO) {
+			rule.Tags = test.response
+		})
+		if !result.Tags.Equal(test.expected) {
+			t.Errorf("%s: expected tags %v, got %v", name, test.expected, result.Tags)
+		}
+	}
+
+	// modules are blocks, missing modules are read as empty lists
+	data := testRuleData()
+	data.Conditions = nil
+	result := testRuleRoundTrip(t, data, func(rule *api.EnrichedRuleDTO) {
+		rule.Conditions = nil
+		rule.Actions = &[]api.ActionDTO{}
+	})
+	if result.Conditions == nil || len(result.Conditions) != 0 || result.Actions == nil || len(result.Actions) != 0 {
+		t.Errorf("expected empty modules, got %v and %v", result.Conditions, result.Actions)
+	}
+
+	// an empty configuration is only kept if it was configured
+	data = testRuleData()
+	data.Triggers[0].Configuration = types.String{Null: true}
+	data.Actions[0].Configuration = types.String{Value: "{}"}
+	result = testRuleRoundTrip(t, data, func(rule *api.EnrichedRuleDTO) {
+		(*rule.Triggers)[0].Configuration = &map[string]interface{}{}
+	})
+	if !result.Triggers[0].Configuration.Null {
+		t.Errorf("expected null trigger configuration, got %v", result.Triggers[0].Configuration)
+	}
+	if result.Actions[0].Configuration.Value != "{}" {
+		t.Errorf("expected empty action configuration, got %v", result.Actions[0].Configuration)
+	}
+}
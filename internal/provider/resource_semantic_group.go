@@ -0,0 +1,446 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/chris922/terraform-provider-openhab/internal/api"
+	"github.com/chris922/terraform-provider-openhab/internal/provider/util"
+	"github.com/chris922/terraform-provider-openhab/internal/provider/validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strings"
+)
+
+// semanticGroupKind describes a resource creating a Group item of the semantic model, e.g. a location.
+type semanticGroupKind struct {
+	// root tag of the semantic class, it is used as semantic tag if no type is set
+	class string
+	// semantic tag used as example in the documentation
+	typeExample string
+}
+
+// semanticGroupSchema returns the schema shared by the resources of the semantic model.
+func semanticGroupSchema(kind semanticGroupKind) tfsdk.Schema {
+	name := strings.ToLower(kind.class)
+
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: fmt.Sprintf("OpenHAB %s of the semantic model, a Group item tagged with a semantic "+
+			"%s tag", kind.class, name),
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				MarkdownDescription: "Resource ID",
+				Computed:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"server_uuid": {
+				MarkdownDescription: "UUID of the openHAB server the item was created on, used to detect an endpoint " +
+					"pointing to a different server",
+				Computed: true,
+				Type:     types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"name": {
+				MarkdownDescription: fmt.Sprintf("Name of the Group item, derived from `parent` and `label` if not "+
+					"set, e.g. `GroundFloor_LivingRoom`. Use it in `group_names` of items or as `parent` of other "+
+					"resources to place them in the %s.", name),
+				Optional: true,
+				Computed: true,
+				Type:     types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+					tfsdk.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					validator.ItemNameValidator(),
+				},
+			},
+			"label": {
+				MarkdownDescription: "Item label",
+				Required:            true,
+				Type:                types.StringType,
+			},
+			"type": {
+				MarkdownDescription: fmt.Sprintf("Semantic %s tag, e.g. `%s`, defaults to `%s`", name,
+					kind.typeExample, kind.class),
+				Optional: true,
+				Type:     types.StringType,
+			},
+			"parent": {
+				MarkdownDescription: fmt.Sprintf("Name of the %s containing the %s. When importing, it is "+
+					"the first group of the item that is a %[1]s.", strings.ToLower(
+					strings.Join(semanticParentRoots[kind.class], " or ")), name),
+				Optional: true,
+				Type:     types.StringType,
+				Validators: []tfsdk.AttributeValidator{
+					validator.ItemNameValidator(),
+				},
+			},
+			"category": {
+				MarkdownDescription: "Item category (often used as the icon)",
+				Optional:            true,
+				Type:                types.StringType,
+			},
+			"tags": {
+				MarkdownDescription: "Additional item tags, semantic location, equipment and point tags are not allowed",
+				Optional:            true,
+				Type:                types.ListType{ElemType: types.StringType},
+			},
+			"group_names": {
+				MarkdownDescription: "Additional item groups besides the parent",
+				Optional:            true,
+				Type:                types.ListType{ElemType: types.StringType},
+				Validators: []tfsdk.AttributeValidator{
+					validator.ItemNameValidator(),
+				},
+			},
+			"adopt_existing": {
+				MarkdownDescription: "Take over an existing item with the same name when creating the item, even if " +
+					"it is not managed by the workspace of the provider. Overrides `adopt_existing_items` of the provider, " +
+					"taking over an item is reported as warning.",
+				Optional: true,
+				Type:     types.BoolType,
+			},
+		},
+	}
+}
+
+type semanticGroupResourceData struct {
+	Id         types.String `tfsdk:"id"`
+	ServerUuid types.String `tfsdk:"server_uuid"`
+
+	// required
+	Label types.String `tfsdk:"label"`
+
+	// optional
+	Name       types.String `tfsdk:"name"`
+	Type       types.String `tfsdk:"type"`
+	Parent     types.String `tfsdk:"parent"`
+	Category   types.String `tfsdk:"category"`
+	Tags       types.List   `tfsdk:"tags"`
+	GroupNames types.List   `tfsdk:"group_names"`
+
+	AdoptExisting types.Bool `tfsdk:"adopt_existing"`
+}
+
+type semanticGroupResource struct {
+	itemRegistry
+
+	kind semanticGroupKind
+}
+
+func newSemanticGroupResource(kind semanticGroupKind, in tfsdk.Provider) (semanticGroupResource, diag.Diagnostics) {
+	provider, diags := ConvertProviderType(in)
+
+	return semanticGroupResource{
+		itemRegistry: newItemRegistry(provider),
+		kind:         kind,
+	}, diags
+}
+
+func (r semanticGroupResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data semanticGroupResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the name is only unknown during planning if label or parent were unknown
+	if data.Name.Unknown || data.Name.Null {
+		data.Name = types.String{Value: semanticItemName(data.Label.Value, data.Parent.Value)}
+		if data.Name.Value == "" {
+			resp.Diagnostics.AddAttributeError(tftypes.NewAttributePath().WithAttributeName("name"),
+				"Invalid Item Name",
+				fmt.Sprintf("Unable to derive an item name from the label %s, set the name explicitly.", data.Label.Value))
+			return
+		}
+	}
+
+	if !data.Parent.Null {
+		diags = checkSemanticParent(ctx, r.client, r.kind.class, data.Parent.Value, true)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	item := r.semanticGroupToItemData(data)
+	diags = r.createItem(ctx, &item)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.itemDataToSemanticGroup(&data, item)
+
+	tflog.Trace(ctx, "created a semantic Group item", map[string]interface{}{"name": data.Name.Value, "class": r.kind.class})
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r semanticGroupResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data semanticGroupResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	item := r.semanticGroupToItemData(data)
+	_, found, diags := r.readItem(ctx, &item, &api.GetItemByNameParams{})
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// an imported item has no id yet, its parent is derived from its groups, afterwards the configured parent is kept
+	if data.Id.Null {
+		data.Parent, diags = findSemanticParent(ctx, r.client, r.kind.class, util.TypeToStringArray(item.GroupNames))
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	r.itemDataToSemanticGroup(&data, item)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r semanticGroupResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var data semanticGroupResourceData
+	var state semanticGroupResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Parent.Null {
+		diags = checkSemanticParent(ctx, r.client, r.kind.class, data.Parent.Value, true)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	item := r.semanticGroupToItemData(data)
+	diags = r.updateItem(ctx, &item, r.semanticGroupToItemData(state))
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.itemDataToSemanticGroup(&data, item)
+
+	tflog.Trace(ctx, "updated a semantic Group item", map[string]interface{}{"name": data.Name.Value, "class": r.kind.class})
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r semanticGroupResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var data semanticGroupResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = r.deleteItem(ctx, data.Name.Value)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r semanticGroupResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	tfsdk.ResourceImportStatePassthroughID(ctx, tftypes.NewAttributePath().WithAttributeName("name"), req, resp)
+}
+
+func (r semanticGroupResource) ValidateConfig(ctx context.Context, req tfsdk.ValidateResourceConfigRequest, resp *tfsdk.ValidateResourceConfigResponse) {
+	var semanticType types.String
+	var tags types.List
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("type"), &semanticType)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("tags"), &tags)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !semanticType.Null && !semanticType.Unknown && validator.SemanticTagRoot(semanticType.Value) != r.kind.class {
+		resp.Diagnostics.AddAttributeError(tftypes.NewAttributePath().WithAttributeName("type"),
+			"Invalid Semantic Type",
+			fmt.Sprintf("Given tag '%s' is no semantic %s tag.", semanticType.Value, strings.ToLower(r.kind.class)))
+	}
+
+	for i, element := range tags.Elems {
+		tag, ok := element.(types.String)
+		if !ok || tag.Null || tag.Unknown {
+			continue
+		}
+
+		if class := semanticClass([]string{tag.Value}); class != "" {
+			resp.Diagnostics.AddAttributeError(tftypes.NewAttributePath().WithAttributeName("tags").WithElementKeyInt(i),
+				"Invalid Tag",
+				fmt.Sprintf("Given tag '%s' is a semantic %s tag, use the attribute type instead.", tag.Value,
+					strings.ToLower(class)))
+		}
+	}
+}
+
+func (r semanticGroupResource) ModifyPlan(ctx context.Context, req tfsdk.ModifyResourcePlanRequest, resp *tfsdk.ModifyResourcePlanResponse) {
+	// the name is only derived when creating the resource, afterwards it is kept in state
+	if req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() {
+		return
+	}
+
+	var name, label, parent types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("name"), &name)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("label"), &label)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("parent"), &parent)...)
+
+	if resp.Diagnostics.HasError() || !name.Unknown || label.Unknown || parent.Unknown {
+		return
+	}
+
+	derived := semanticItemName(label.Value, parent.Value)
+	if derived == "" {
+		resp.Diagnostics.AddAttributeError(tftypes.NewAttributePath().WithAttributeName("name"),
+			"Invalid Item Name",
+			fmt.Sprintf("Unable to derive an item name from the label %s, set the name explicitly.", label.Value))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("name"),
+		types.String{Value: derived})...)
+}
+
+// semanticType returns the semantic tag of the item, the root tag of the class is used if no type is set.
+func (r semanticGroupResource) semanticType(data semanticGroupResourceData) string {
+	if data.Type.Null {
+		return r.kind.class
+	}
+
+	return data.Type.Value
+}
+
+// semanticGroupToItemData converts the resource data to the Group item, the semantic tag and the parent are added to
+// the configured tags and groups.
+func (r semanticGroupResource) semanticGroupToItemData(data semanticGroupResourceData) itemResourceData {
+	tags := []string{r.semanticType(data)}
+	if configured := util.TypeToStringArray(data.Tags); configured != nil {
+		tags = append(tags, *configured...)
+	}
+
+	var groupNames []string
+	if !data.Parent.Null {
+		groupNames = append(groupNames, data.Parent.Value)
+	}
+	if configured := util.TypeToStringArray(data.GroupNames); configured != nil {
+		groupNames = append(groupNames, *configured...)
+	}
+
+	return itemResourceData{
+		Id:         data.Id,
+		ServerUuid: data.ServerUuid,
+		Name:       data.Name,
+		Label:      data.Label,
+		Type:       types.String{Value: "Group"},
+
+		Category:   data.Category,
+		Tags:       util.StringArrayToType(&tags),
+		GroupNames: util.StringArrayToType(&groupNames),
+		GroupType:  types.String{Null: true},
+		Unit:       types.String{Null: true},
+
+		AdoptExisting: data.AdoptExisting,
+	}
+}
+
+// itemDataToSemanticGroup stores the Group item read from openHAB in the given resource data. The semantic tag and the
+// parent are separated from the other tags and groups.
+func (r semanticGroupResource) itemDataToSemanticGroup(data *semanticGroupResourceData, item itemResourceData) {
+	data.Id = item.Id
+	data.ServerUuid = item.ServerUuid
+	data.Name = item.Name
+	data.Label = item.Label
+	data.Category = item.Category
+
+	semanticType := ""
+	var tags []string
+	if itemTags := util.TypeToStringArray(item.Tags); itemTags != nil {
+		for _, tag := range *itemTags {
+			if semanticType == "" && validator.SemanticTagRoot(tag) == r.kind.class {
+				semanticType = tag
+				continue
+			}
+			tags = append(tags, tag)
+		}
+	}
+
+	switch {
+	case semanticType == "":
+		// an empty type differs from every configuration, so the removed semantic tag is planned to be added again
+		data.Type = types.String{Value: ""}
+	case semanticType == r.kind.class && data.Type.Null:
+		// the root tag is the default type
+	default:
+		data.Type = types.String{Value: semanticType}
+	}
+	data.Tags = util.NormalizeStringArrayToType(data.Tags, &tags)
+
+	parentFound := false
+	var groupNames []string
+	if itemGroupNames := util.TypeToStringArray(item.GroupNames); itemGroupNames != nil {
+		for _, groupName := range *itemGroupNames {
+			if !parentFound && !data.Parent.Null && groupName == data.Parent.Value {
+				parentFound = true
+				continue
+			}
+			groupNames = append(groupNames, groupName)
+		}
+	}
+
+	if !parentFound {
+		data.Parent = types.String{Null: true}
+	}
+	data.GroupNames = util.NormalizeStringArrayToType(data.GroupNames, &groupNames)
+}
@@ -0,0 +1,267 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/chris922/terraform-provider-openhab/internal/provider/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func testSemanticGroupData() semanticGroupResourceData {
+	return semanticGroupResourceData{
+		Id:         types.String{Unknown: true},
+		ServerUuid: types.String{Unknown: true},
+		Name:       types.String{Unknown: true},
+		Label:      types.String{Value: "Living Room"},
+		Type:       types.String{Value: "LivingRoom"},
+		Parent:     types.String{Value: "GroundFloor"},
+		Category:   types.String{Null: true},
+		Tags:       types.List{ElemType: types.StringType, Null: true},
+		GroupNames: types.List{ElemType: types.StringType, Null: true},
+
+		AdoptExisting: types.Bool{Null: true},
+	}
+}
+
+func TestSemanticItemName(t *testing.T) {
+	tests := []struct {
+		label  string
+		parent string
+		name   string
+	}{
+		{label: "Living Room", parent: "", name: "LivingRoom"},
+		{label: "Living Room", parent: "GroundFloor", name: "GroundFloor_LivingRoom"},
+		{label: "Küche", parent: "", name: "Kueche"},
+		{label: "1st floor", parent: "", name: "_1stFloor"},
+		{label: "ceiling light (dimmable)", parent: "Kitchen", name: "Kitchen_CeilingLightDimmable"},
+		{label: "!!!", parent: "Kitchen", name: ""},
+	}
+
+	for _, test := range tests {
+		name := semanticItemName(test.label, test.parent)
+		if name != test.name {
+			t.Errorf("expected name %q for label %q and parent %q, got %q", test.name, test.label, test.parent, name)
+		}
+		if name != "" && validator.ValidateItemName(name) != nil {
+			t.Errorf("expected derived name %q to be a valid item name", name)
+		}
+	}
+}
+
+func TestCheckSemanticParent(t *testing.T) {
+	ctx := context.Background()
+
+	tests := map[string]struct {
+		class       string
+		status      int
+		body        string
+		required    bool
+		expectError bool
+	}{
+		"equipment in location": {
+			class:  validator.SemanticEquipment,
+			status: http.StatusOK,
+			body:   `{"name": "parent", "type": "Group", "tags": ["Kitchen"]}`,
+		},
+		"equipment in equipment": {
+			class:  validator.SemanticEquipment,
+			status: http.StatusOK,
+			body:   `{"name": "parent", "type": "Group", "tags": ["HVAC"]}`,
+		},
+		"location in equipment": {
+			class:       validator.SemanticLocation,
+			status:      http.StatusOK,
+			body:        `{"name": "parent", "type": "Group", "tags": ["HVAC"]}`,
+			expectError: true,
+		},
+		"point in point": {
+			class:       validator.SemanticPoint,
+			status:      http.StatusOK,
+			body:        `{"name": "parent", "type": "Switch", "tags": ["Switch", "Light"]}`,
+			expectError: true,
+		},
+		"point in other group": {
+			class:  validator.SemanticPoint,
+			status: http.StatusOK,
+			body:   `{"name": "parent", "type": "Group", "tags": ["persisted"]}`,
+		},
+		"location in other group": {
+			class:       validator.SemanticLocation,
+			status:      http.StatusOK,
+			body:        `{"name": "parent", "type": "Group"}`,
+			required:    true,
+			expectError: true,
+		},
+		"missing group": {
+			class:  validator.SemanticPoint,
+			status: http.StatusNotFound,
+			body:   `{}`,
+		},
+		"missing parent": {
+			class:       validator.SemanticEquipment,
+			status:      http.StatusNotFound,
+			body:        `{}`,
+			required:    true,
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		client := testServer(t, "/items/parent", test.status, test.body)
+
+		diags := checkSemanticParent(ctx, client, test.class, "parent", test.required)
+		if diags.HasError() != test.expectError {
+			t.Errorf("%s: expected error to be %t, got %v", name, test.expectError, diags)
+		}
+	}
+}
+
+func TestSemanticGroupModifyPlanDerivesName(t *testing.T) {
+	ctx := context.Background()
+
+	schema, diags := LocationResourceType{}.GetSchema(ctx)
+	if diags.HasError() {
+		t.Fatalf("unable to get schema: %v", diags)
+	}
+
+	data := testSemanticGroupData()
+	plan := testState(t, schema, &data)
+	req := tfsdk.ModifyResourcePlanRequest{
+		Plan:  tfsdk.Plan{Schema: schema, Raw: plan.Raw},
+		State: tfsdk.State{Schema: schema, Raw: tftypes.NewValue(schema.TerraformType(ctx), nil)},
+	}
+	resp := &tfsdk.ModifyResourcePlanResponse{Plan: req.Plan}
+	semanticGroupResource{kind: locationKind}.ModifyPlan(ctx, req, resp)
+
+	var name types.String
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("name"), &name)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	if name.Value != "GroundFloor_LivingRoom" {
+		t.Errorf("expected name to be derived from parent and label, got %v", name)
+	}
+}
+
+// testSemanticGroupRead reads a location from a server answering the given routes, see testRoutes.
+func testSemanticGroupRead(t *testing.T, state tfsdk.State, routes map[string]string) semanticGroupResourceData {
+	ctx := context.Background()
+
+	r := semanticGroupResource{
+		itemRegistry: itemRegistry{
+			client:   testRoutes(t, routes),
+			defaults: itemDefaults{tags: []string{"terraform"}},
+		},
+		kind: locationKind,
+	}
+
+	resp := &tfsdk.ReadResourceResponse{State: state}
+	r.Read(ctx, tfsdk.ReadResourceRequest{State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error reading location: %v", resp.Diagnostics)
+	}
+
+	var result semanticGroupResourceData
+	if diags := resp.State.Get(ctx, &result); diags.HasError() {
+		t.Fatalf("unable to get state: %v", diags)
+	}
+
+	return result
+}
+
+func TestSemanticGroupReadSeparatesSemanticTagAndParent(t *testing.T) {
+	ctx := context.Background()
+
+	schema, diags := LocationResourceType{}.GetSchema(ctx)
+	if diags.HasError() {
+		t.Fatalf("unable to get schema: %v", diags)
+	}
+
+	prior := testSemanticGroupData()
+	prior.Id = types.String{Value: "GroundFloor_LivingRoom"}
+	prior.ServerUuid = types.String{Null: true}
+	prior.Name = types.String{Value: "GroundFloor_LivingRoom"}
+	prior.Tags = testStringList("favorite")
+
+	data := testSemanticGroupRead(t, testState(t, schema, &prior), map[string]string{
+		"/items/GroundFloor_LivingRoom": `{"name": "GroundFloor_LivingRoom", "type": "Group",
+			"tags": ["terraform", "LivingRoom", "favorite"], "groupNames": ["gPersisted", "GroundFloor"]}`,
+	})
+	if data.Type.Value != "LivingRoom" || data.Parent.Value != "GroundFloor" {
+		t.Errorf("expected semantic tag and parent to be read, got %v and %v", data.Type, data.Parent)
+	}
+	if !data.Tags.Equal(testStringList("favorite")) {
+		t.Errorf("expected only additional tags, got %v", data.Tags)
+	}
+	if !data.GroupNames.Equal(testStringList("gPersisted")) {
+		t.Errorf("expected only additional groups, got %v", data.GroupNames)
+	}
+
+	// the root tag is the default type, a missing semantic tag is a difference to every configuration
+	prior.Type = types.String{Null: true}
+	data = testSemanticGroupRead(t, testState(t, schema, &prior), map[string]string{
+		"/items/GroundFloor_LivingRoom": `{"name": "GroundFloor_LivingRoom", "type": "Group", "tags": ["Location"]}`,
+	})
+	if !data.Type.Null {
+		t.Errorf("expected default type to stay null, got %v", data.Type)
+	}
+	if !data.Parent.Null {
+		t.Errorf("expected removed parent to be detected, got %v", data.Parent)
+	}
+
+	data = testSemanticGroupRead(t, testState(t, schema, &prior), map[string]string{
+		"/items/GroundFloor_LivingRoom": `{"name": "GroundFloor_LivingRoom", "type": "Group", "tags": []}`,
+	})
+	if data.Type.Null || data.Type.Value != "" {
+		t.Errorf("expected missing semantic tag to be detected, got %v", data.Type)
+	}
+}
+
+func TestSemanticGroupImport(t *testing.T) {
+	ctx := context.Background()
+
+	schema, diags := LocationResourceType{}.GetSchema(ctx)
+	if diags.HasError() {
+		t.Fatalf("unable to get schema: %v", diags)
+	}
+
+	importResp := &tfsdk.ImportResourceStateResponse{
+		State: tfsdk.State{Schema: schema, Raw: tftypes.NewValue(schema.TerraformType(ctx), nil)},
+	}
+	semanticGroupResource{kind: locationKind}.ImportState(ctx,
+		tfsdk.ImportResourceStateRequest{ID: "GroundFloor_LivingRoom"}, importResp)
+	if importResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error importing location: %v", importResp.Diagnostics)
+	}
+
+	// the parent is the group that is a location, other groups are kept in group_names
+	routes := map[string]string{
+		"/items/GroundFloor_LivingRoom": `{"name": "GroundFloor_LivingRoom", "label": "Living Room", "type": "Group",
+			"tags": ["LivingRoom", "favorite"], "groupNames": ["gPersisted", "gHeating", "GroundFloor"]}`,
+		"/items/gPersisted":  `{"name": "gPersisted", "type": "Group"}`,
+		"/items/gHeating":    `{"name": "gHeating", "type": "Group", "tags": ["HVAC"]}`,
+		"/items/GroundFloor": `{"name": "GroundFloor", "type": "Group", "tags": ["GroundFloor"]}`,
+	}
+	data := testSemanticGroupRead(t, importResp.State, routes)
+
+	if data.Id.Value != "GroundFloor_LivingRoom" || data.Label.Value != "Living Room" || data.Type.Value != "LivingRoom" {
+		t.Errorf("expected imported location, got %v", data)
+	}
+	if data.Parent.Value != "GroundFloor" {
+		t.Errorf("expected parent to be derived from the groups, got %v", data.Parent)
+	}
+	if !data.GroupNames.Equal(testStringList("gPersisted", "gHeating")) || !data.Tags.Equal(testStringList("favorite")) {
+		t.Errorf("expected only additional groups and tags, got %v and %v", data.GroupNames, data.Tags)
+	}
+
+	// the state after the import is read like the state of a created location
+	state := testState(t, schema, &data)
+	if result := testSemanticGroupRead(t, state, routes); result.Parent != data.Parent ||
+		!result.GroupNames.Equal(data.GroupNames) || !result.Tags.Equal(data.Tags) || result.Type != data.Type {
+		t.Errorf("expected imported state to be stable, got %v", result)
+	}
+}
@@ -0,0 +1,488 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/chris922/terraform-provider-openhab/internal/api"
+	"github.com/chris922/terraform-provider-openhab/internal/provider/util"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type ThingResourceType struct{}
+
+func (t ThingResourceType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "OpenHAB Thing",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				MarkdownDescription: "Resource ID",
+				Computed:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"uid": {
+				MarkdownDescription: "Thing UID, e.g. `modbus:tcp:smartenergymeter`",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"thing_type_uid": {
+				MarkdownDescription: "Thing type UID, e.g. `modbus:tcp`",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"bridge_uid": {
+				MarkdownDescription: "UID of the bridge this thing belongs to",
+				Optional:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+			"label": {
+				MarkdownDescription: "Thing label",
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+			"location": {
+				MarkdownDescription: "Thing location",
+				Optional:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+			"configuration": {
+				MarkdownDescription: "Thing configuration. Values are normalized by openHAB based on the config " +
+					"description of the thing type. Only the keys set here are tracked, defaults added by openHAB are ignored.",
+				Optional: true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+				Type: types.MapType{ElemType: types.StringType},
+			},
+			"channels": {
+				MarkdownDescription: "Thing channels. If not set, the channels defined by the thing type are used.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"id": {
+						MarkdownDescription: "Channel ID, the channel UID is the thing UID followed by `:` and this ID",
+						Required:            true,
+						Type:                types.StringType,
+					},
+					"channel_type_uid": {
+						MarkdownDescription: "Channel type UID",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: tfsdk.AttributePlanModifiers{
+							tfsdk.UseStateForUnknown(),
+						},
+						Type: types.StringType,
+					},
+					"item_type": {
+						MarkdownDescription: "Item type accepted by this channel",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: tfsdk.AttributePlanModifiers{
+							tfsdk.UseStateForUnknown(),
+						},
+						Type: types.StringType,
+					},
+					"kind": {
+						MarkdownDescription: "Channel kind, either `STATE` or `TRIGGER`",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: tfsdk.AttributePlanModifiers{
+							tfsdk.UseStateForUnknown(),
+						},
+						Type: types.StringType,
+					},
+					"label": {
+						MarkdownDescription: "Channel label",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: tfsdk.AttributePlanModifiers{
+							tfsdk.UseStateForUnknown(),
+						},
+						Type: types.StringType,
+					},
+					"description": {
+						MarkdownDescription: "Channel description",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: tfsdk.AttributePlanModifiers{
+							tfsdk.UseStateForUnknown(),
+						},
+						Type: types.StringType,
+					},
+					"configuration": {
+						MarkdownDescription: "Channel configuration",
+						Optional:            true,
+						Type:                types.MapType{ElemType: types.StringType},
+					},
+				}, tfsdk.ListNestedAttributesOptions{}),
+			},
+		},
+	}, nil
+}
+
+func (t ThingResourceType) NewResource(_ context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := ConvertProviderType(in)
+
+	return thingResource{
+		client: provider.Client,
+	}, diags
+}
+
+type thingResourceData struct {
+	Id types.String `tfsdk:"id"`
+
+	// required
+	Uid          types.String `tfsdk:"uid"`
+	ThingTypeUid types.String `tfsdk:"thing_type_uid"`
+	Label        types.String `tfsdk:"label"`
+
+	// optional
+	BridgeUid     types.String       `tfsdk:"bridge_uid"`
+	Location      types.String       `tfsdk:"location"`
+	Configuration types.Map          `tfsdk:"configuration"`
+	Channels      []thingChannelData `tfsdk:"channels"`
+}
+
+type thingChannelData struct {
+	// required
+	Id types.String `tfsdk:"id"`
+
+	// optional
+	ChannelTypeUid types.String `tfsdk:"channel_type_uid"`
+	ItemType       types.String `tfsdk:"item_type"`
+	Kind           types.String `tfsdk:"kind"`
+	Label          types.String `tfsdk:"label"`
+	Description    types.String `tfsdk:"description"`
+	Configuration  types.Map    `tfsdk:"configuration"`
+}
+
+type thingResource struct {
+	client *api.Client
+}
+
+func (r thingResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data thingResourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := api.CreateThingInRegistryJSONRequestBody(thingDataToDTO(data))
+	apiResp, err := r.client.CreateThingInRegistry(ctx, &api.CreateThingInRegistryParams{}, body)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Thing Error",
+			fmt.Sprintf("Unable to create thing %s, got error: %s", data.Uid.Value, err))
+		return
+	}
+
+	if apiResp.StatusCode == 409 {
+		resp.Diagnostics.AddError("Create Thing Error",
+			fmt.Sprintf("Thing %s already exists, import it instead", data.Uid.Value))
+		return
+	} else if apiResp.StatusCode != 201 {
+		resp.Diagnostics.AddError("Create Thing Error",
+			fmt.Sprintf("Unable to create thing %s, got error: %s", data.Uid.Value, api.ReadResponseError(apiResp)))
+		return
+	}
+
+	apiRespObj := &api.EnrichedThingDTO{}
+	err = api.ReadResponseBody(apiResp, apiRespObj)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Thing Error",
+			fmt.Sprintf("Unable to read response of creating thing %s, got error: %s", data.Uid.Value, err))
+		return
+	}
+
+	// store enriched thing to resource
+	enrichedThingToData(&data, apiRespObj)
+
+	tflog.Trace(ctx, "created a Thing resource", map[string]interface{}{"uid": data.Uid.Value})
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r thingResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data thingResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, err := r.client.GetThingById(ctx, data.Uid.Value, &api.GetThingByIdParams{})
+	if err != nil {
+		resp.Diagnostics.AddError("Read Thing Error",
+			fmt.Sprintf("Unable to read thing %s, got error: %s", data.Uid.Value, err))
+		return
+	}
+
+	if apiResp.StatusCode == 404 {
+		tflog.Debug(ctx, "Thing not found, will be removed from state", map[string]interface{}{"uid": data.Uid.Value})
+
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if apiResp.StatusCode != 200 {
+		resp.Diagnostics.AddError("Read Thing Error",
+			fmt.Sprintf("Unable to read thing %s, got error: %s", data.Uid.Value, api.ReadResponseError(apiResp)))
+		return
+	}
+
+	apiRespObj := &api.EnrichedThingDTO{}
+	err = api.ReadResponseBody(apiResp, apiRespObj)
+	if err != nil {
+		resp.Diagnostics.AddError("Read Thing Error",
+			fmt.Sprintf("Unable to read response of reading thing %s, got error: %s", data.Uid.Value, err))
+		return
+	}
+
+	// store enriched thing to resource
+	enrichedThingToData(&data, apiRespObj)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r thingResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var data thingResourceData
+	var state thingResourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keepComputedChannelValues(data.Channels, state.Channels)
+
+	body := api.UpdateThingJSONRequestBody(thingDataToDTO(data))
+	apiResp, err := r.client.UpdateThing(ctx, data.Uid.Value, &api.UpdateThingParams{}, body)
+	if err != nil {
+		resp.Diagnostics.AddError("Update Thing Error",
+			fmt.Sprintf("Unable to update thing %s, got error: %s", data.Uid.Value, err))
+		return
+	}
+
+	if apiResp.StatusCode == 409 {
+		resp.Diagnostics.AddError("Update Thing Error",
+			fmt.Sprintf("Thing %s is not editable, it is probably defined in a .things file", data.Uid.Value))
+		return
+	} else if apiResp.StatusCode != 200 {
+		resp.Diagnostics.AddError("Update Thing Error",
+			fmt.Sprintf("Unable to update thing %s, got error: %s", data.Uid.Value, api.ReadResponseError(apiResp)))
+		return
+	}
+
+	apiRespObj := &api.EnrichedThingDTO{}
+	err = api.ReadResponseBody(apiResp, apiRespObj)
+	if err != nil {
+		resp.Diagnostics.AddError("Update Thing Error",
+			fmt.Sprintf("Unable to read response of updating thing %s, got error: %s", data.Uid.Value, err))
+		return
+	}
+
+	// store enriched thing to resource
+	enrichedThingToData(&data, apiRespObj)
+
+	tflog.Trace(ctx, "updated a Thing resource", map[string]interface{}{"uid": data.Uid.Value})
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r thingResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var data thingResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, err := r.client.RemoveThingById(ctx, data.Uid.Value, &api.RemoveThingByIdParams{})
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Thing Error",
+			fmt.Sprintf("Unable to delete thing %s, got error: %s", data.Uid.Value, err))
+		return
+	}
+
+	// 202 is returned if the thing handler is still removing the thing asynchronously
+	if apiResp.StatusCode == 404 {
+		tflog.Debug(ctx, "Planned to remove a thing, but it was already removed", map[string]interface{}{"uid": data.Uid.Value})
+	} else if apiResp.StatusCode != 200 && apiResp.StatusCode != 202 {
+		resp.Diagnostics.AddError("Delete Thing Error",
+			fmt.Sprintf("Unable to delete thing %s, got error: %s", data.Uid.Value, api.ReadResponseError(apiResp)))
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r thingResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	tfsdk.ResourceImportStatePassthroughID(ctx, tftypes.NewAttributePath().WithAttributeName("uid"), req, resp)
+}
+
+// thingDataToDTO converts the resource data into a thing DTO. If no channels are given, openHAB uses the channels of
+// the thing type on create and keeps the current channels on update.
+func thingDataToDTO(data thingResourceData) api.ThingDTO {
+	var channels *[]api.ChannelDTO
+	if data.Channels != nil {
+		channels = &[]api.ChannelDTO{}
+		for _, channel := range data.Channels {
+			// the channel UID is required by openHAB, it is always built out of thing UID and channel ID
+			uid := data.Uid.Value + ":" + channel.Id.Value
+
+			*channels = append(*channels, api.ChannelDTO{
+				Uid:            &uid,
+				Id:             util.TypeToString(channel.Id),
+				ChannelTypeUID: util.TypeToString(channel.ChannelTypeUid),
+				ItemType:       util.TypeToString(channel.ItemType),
+				Kind:           util.TypeToString(channel.Kind),
+				Label:          util.TypeToString(channel.Label),
+				Description:    util.TypeToString(channel.Description),
+				Configuration:  util.TypeToInterfaceMap(channel.Configuration),
+			})
+		}
+	}
+
+	return api.ThingDTO{
+		UID:          util.TypeToString(data.Uid),
+		ThingTypeUID: util.TypeToString(data.ThingTypeUid),
+		Label:        util.TypeToString(data.Label),
+
+		BridgeUID:     util.TypeToString(data.BridgeUid),
+		Location:      util.TypeToString(data.Location),
+		Configuration: util.TypeToInterfaceMap(data.Configuration),
+		Channels:      channels,
+	}
+}
+
+func enrichedThingToData(data *thingResourceData, apiRespObj *api.EnrichedThingDTO) {
+	data.Id = util.StringToType(apiRespObj.UID)
+	data.Uid = util.StringToType(apiRespObj.UID)
+	data.ThingTypeUid = util.StringToType(apiRespObj.ThingTypeUID)
+	data.Label = util.StringToType(apiRespObj.Label)
+
+	data.BridgeUid = util.StringToType(apiRespObj.BridgeUID)
+	data.Location = util.StringToType(apiRespObj.Location)
+	data.Configuration = filterConfiguration(data.Configuration, util.InterfaceMapToType(apiRespObj.Configuration))
+
+	priorChannels := make(map[string]thingChannelData, len(data.Channels))
+	for _, channel := range data.Channels {
+		priorChannels[channel.Id.Value] = channel
+	}
+
+	data.Channels = nil
+	if apiRespObj.Channels != nil {
+		data.Channels = []thingChannelData{}
+		for _, channel := range *apiRespObj.Channels {
+			channelData := thingChannelData{
+				Id:             util.StringToType(channel.Id),
+				ChannelTypeUid: util.StringToType(channel.ChannelTypeUID),
+				ItemType:       util.StringToType(channel.ItemType),
+				Kind:           util.StringToType(channel.Kind),
+				Label:          util.StringToType(channel.Label),
+				Description:    util.StringToType(channel.Description),
+			}
+
+			priorConfiguration := types.Map{ElemType: types.StringType, Null: true}
+			if prior, ok := priorChannels[channelData.Id.Value]; ok {
+				priorConfiguration = prior.Configuration
+			}
+			channelData.Configuration = filterConfiguration(priorConfiguration,
+				util.InterfaceMapToType(channel.Configuration))
+
+			data.Channels = append(data.Channels, channelData)
+		}
+	}
+}
+
+// keepComputedChannelValues fills channel attributes that are not configured with the values of the prior state.
+// openHAB derives e.g. label and item type from the channel type on create, but drops them if an update omits them.
+func keepComputedChannelValues(channels []thingChannelData, priorChannels []thingChannelData) {
+	prior := make(map[string]thingChannelData, len(priorChannels))
+	for _, channel := range priorChannels {
+		prior[channel.Id.Value] = channel
+	}
+
+	for i := range channels {
+		p, ok := prior[channels[i].Id.Value]
+		if !ok {
+			continue
+		}
+
+		channels[i].ChannelTypeUid = keepComputedValue(channels[i].ChannelTypeUid, p.ChannelTypeUid)
+		channels[i].ItemType = keepComputedValue(channels[i].ItemType, p.ItemType)
+		channels[i].Kind = keepComputedValue(channels[i].Kind, p.Kind)
+		channels[i].Label = keepComputedValue(channels[i].Label, p.Label)
+		channels[i].Description = keepComputedValue(channels[i].Description, p.Description)
+	}
+}
+
+func keepComputedValue(configured types.String, prior types.String) types.String {
+	if configured.Null || configured.Unknown {
+		return prior
+	}
+
+	return configured
+}
+
+// filterConfiguration reduces the configuration returned by openHAB to the keys that are managed by Terraform.
+// openHAB adds default values of the config description to the configuration, these would otherwise always
+// show up as a difference.
+func filterConfiguration(managed types.Map, actual types.Map) types.Map {
+	if managed.Null || managed.Unknown || actual.Null {
+		return types.Map{ElemType: types.StringType, Null: true}
+	}
+
+	filtered := make(map[string]attr.Value, len(managed.Elems))
+	for k := range managed.Elems {
+		if v, ok := actual.Elems[k]; ok {
+			filtered[k] = v
+		}
+	}
+
+	return types.Map{
+		ElemType: types.StringType,
+		Elems:    filtered,
+	}
+}
@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/chris922/terraform-provider-openhab/internal/api"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testStringMap(values map[string]string) types.Map {
+	elems := make(map[string]attr.Value, len(values))
+	for k, v := range values {
+		elems[k] = types.String{Value: v}
+	}
+
+	return types.Map{ElemType: types.StringType, Elems: elems}
+}
+
+func TestThingFilterConfiguration(t *testing.T) {
+	actual := testStringMap(map[string]string{"host": "192.168.0.10", "port": "502", "timeBetweenTransactionsMillis": "60"})
+
+	tests := map[string]struct {
+		managed  types.Map
+		actual   types.Map
+		expected types.Map
+	}{
+		"unmanaged": {
+			managed:  types.Map{ElemType: types.StringType, Null: true},
+			actual:   actual,
+			expected: types.Map{ElemType: types.StringType, Null: true},
+		},
+		"unknown": {
+			managed:  types.Map{ElemType: types.StringType, Unknown: true},
+			actual:   actual,
+			expected: types.Map{ElemType: types.StringType, Null: true},
+		},
+		"defaults are ignored": {
+			managed:  testStringMap(map[string]string{"host": "192.168.0.1", "port": "502"}),
+			actual:   actual,
+			expected: testStringMap(map[string]string{"host": "192.168.0.10", "port": "502"}),
+		},
+		"removed key": {
+			managed:  testStringMap(map[string]string{"host": "192.168.0.10", "id": "1"}),
+			actual:   actual,
+			expected: testStringMap(map[string]string{"host": "192.168.0.10"}),
+		},
+		"empty": {
+			managed:  testStringMap(map[string]string{}),
+			actual:   actual,
+			expected: testStringMap(map[string]string{}),
+		},
+		"missing configuration": {
+			managed:  testStringMap(map[string]string{"host": "192.168.0.10"}),
+			actual:   types.Map{ElemType: types.StringType, Null: true},
+			expected: types.Map{ElemType: types.StringType, Null: true},
+		},
+	}
+
+	for name, test := range tests {
+		result := filterConfiguration(test.managed, test.actual)
+		if !result.Equal(test.expected) {
+			t.Errorf("%s: expected %v, got %v", name, test.expected, result)
+		}
+	}
+}
+
+func TestThingDTORoundTrip(t *testing.T) {
+	data := thingResourceData{
+		Id:            types.String{Value: "modbus:tcp:meter"},
+		Uid:           types.String{Value: "modbus:tcp:meter"},
+		ThingTypeUid:  types.String{Value: "modbus:tcp"},
+		Label:         types.String{Value: "Meter"},
+		BridgeUid:     types.String{Null: true},
+		Location:      types.String{Value: "Basement"},
+		Configuration: testStringMap(map[string]string{"host": "192.168.0.10", "port": "502"}),
+		Channels: []thingChannelData{
+			{
+				Id:             types.String{Value: "power"},
+				ChannelTypeUid: types.String{Value: "modbus:number"},
+				ItemType:       types.String{Value: "Number:Power"},
+				Kind:           types.String{Value: "STATE"},
+				Label:          types.String{Value: "Power"},
+				Description:    types.String{Null: true},
+				Configuration:  testStringMap(map[string]string{"readValueType": "int16"}),
+			},
+		},
+	}
+
+	dto := thingDataToDTO(data)
+	if uid := *(*dto.Channels)[0].Uid; uid != "modbus:tcp:meter:power" {
+		t.Errorf("expected channel UID to be built out of thing UID and channel ID, got %s", uid)
+	}
+
+	// openHAB returns typed configuration values and adds defaults of the config description
+	body, err := json.Marshal(dto)
+	if err != nil {
+		t.Fatalf("unable to marshal thing: %s", err)
+	}
+	enriched := &api.EnrichedThingDTO{}
+	if err := json.Unmarshal(body, enriched); err != nil {
+		t.Fatalf("unable to unmarshal thing: %s", err)
+	}
+	(*enriched.Configuration)["port"] = 502.0
+	(*enriched.Configuration)["reconnectAfterMillis"] = 0.0
+	(*(*enriched.Channels)[0].Configuration)["readStart"] = ""
+
+	result := data
+	result.Channels = append([]thingChannelData{}, data.Channels...)
+	enrichedThingToData(&result, enriched)
+
+	if !result.Configuration.Equal(data.Configuration) {
+		t.Errorf("expected configuration %v, got %v", data.Configuration, result.Configuration)
+	}
+	if len(result.Channels) != 1 {
+		t.Fatalf("expected a single channel, got %v", result.Channels)
+	}
+	channel := result.Channels[0]
+	if !channel.Configuration.Equal(data.Channels[0].Configuration) {
+		t.Errorf("expected channel configuration %v, got %v", data.Channels[0].Configuration, channel.Configuration)
+	}
+	if !channel.Label.Equal(data.Channels[0].Label) || !channel.ItemType.Equal(data.Channels[0].ItemType) ||
+		!channel.Description.Null {
+		t.Errorf("expected channel %v, got %v", data.Channels[0], channel)
+	}
+	if !result.BridgeUid.Null || result.Location.Value != "Basement" {
+		t.Errorf("expected bridge UID to be null and location to be read, got %v and %v", result.BridgeUid,
+			result.Location)
+	}
+}
+
+func TestThingUpdateKeepsComputedChannelValues(t *testing.T) {
+	configured := []thingChannelData{
+		{
+			Id:             types.String{Value: "power"},
+			ChannelTypeUid: types.String{Value: "modbus:number"},
+			ItemType:       types.String{Null: true},
+			Kind:           types.String{Null: true},
+			Label:          types.String{Value: "Active Power"},
+			Description:    types.String{Null: true},
+		},
+		{
+			Id:          types.String{Value: "energy"},
+			ItemType:    types.String{Null: true},
+			Label:       types.String{Null: true},
+			Description: types.String{Null: true},
+		},
+	}
+	prior := []thingChannelData{
+		{
+			Id:             types.String{Value: "power"},
+			ChannelTypeUid: types.String{Value: "modbus:number"},
+			ItemType:       types.String{Value: "Number:Power"},
+			Kind:           types.String{Value: "STATE"},
+			Label:          types.String{Value: "Power"},
+			Description:    types.String{Value: "Current power"},
+		},
+	}
+
+	keepComputedChannelValues(configured, prior)
+
+	if configured[0].Label.Value != "Active Power" {
+		t.Errorf("expected configured label to be kept, got %v", configured[0].Label)
+	}
+	if configured[0].ItemType.Value != "Number:Power" || configured[0].Kind.Value != "STATE" ||
+		configured[0].Description.Value != "Current power" {
+		t.Errorf("expected computed values of the prior state, got %v", configured[0])
+	}
+	if !configured[1].Label.Null || !configured[1].ItemType.Null {
+		t.Errorf("expected new channel to be left untouched, got %v", configured[1])
+	}
+}
@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/chris922/terraform-provider-openhab/internal/api"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"sync"
+)
+
+// minimum number of resources of a kind that have to be read before the fraction of missing ones is evaluated
+const safeguardMinChecked = 10
+
+// safeguard protects the state against a misconfigured endpoint, e.g. pointing at a fresh openHAB instance. In this
+// case every resource would be missing, removed from state and planned to be recreated. All methods can be called on
+// a nil safeguard, e.g. in tests, and do not check anything then.
+type safeguard struct {
+	client             *api.Client
+	serverUuid         string
+	maxMissingFraction float64
+
+	mu           sync.Mutex
+	checkedCount map[string]int
+	missingCount map[string]int
+	// whether the server has any items, only requested once
+	hasItems *bool
+}
+
+// newSafeguard creates a safeguard for the given server, the client is used to check if the server is empty.
+func newSafeguard(client *api.Client, serverUuid string, maxMissingFraction float64) *safeguard {
+	return &safeguard{
+		client:             client,
+		serverUuid:         serverUuid,
+		maxMissingFraction: maxMissingFraction,
+		checkedCount:       map[string]int{},
+		missingCount:       map[string]int{},
+	}
+}
+
+// serverUuidValue returns the UUID of the configured server that is recorded when creating a resource.
+func (s *safeguard) serverUuidValue() types.String {
+	if s == nil || s.serverUuid == "" {
+		return types.String{Null: true}
+	}
+
+	return types.String{Value: s.serverUuid}
+}
+
+// keepServerUuid returns the given recorded UUID, resources created before the UUID was recorded get the current one.
+func (s *safeguard) keepServerUuid(recordedUuid types.String) types.String {
+	if recordedUuid.Null || recordedUuid.Unknown {
+		return s.serverUuidValue()
+	}
+
+	return recordedUuid
+}
+
+// checkServer verifies that a resource is read from the server it was created on.
+func (s *safeguard) checkServer(kind string, id string, recordedUuid types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if s == nil || s.serverUuid == "" || recordedUuid.Null || recordedUuid.Unknown || recordedUuid.Value == s.serverUuid {
+		return diags
+	}
+
+	diags.AddError("Server Mismatch",
+		fmt.Sprintf("The %s %s was created on the openHAB server with UUID %s, but the endpoint points to the server "+
+			"with UUID %s. Check the configured endpoint, if the server was replaced on purpose, remove the %s "+
+			"from state and import it again.", kind, id, recordedUuid.Value, s.serverUuid, kind))
+
+	return diags
+}
+
+// found records that a resource of the given kind exists.
+func (s *safeguard) found(kind string) {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.checkedCount[kind]++
+}
+
+// missing records that a resource of the given kind does not exist anymore and returns an error if too many
+// resources of this kind are missing. In this case the resource must not be removed from state. Until
+// safeguardMinChecked resources of a kind were read, the fraction is not meaningful, so a missing resource is only
+// kept if none of this kind was found so far and the server has no items at all.
+func (s *safeguard) missing(ctx context.Context, kind string, id string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if s == nil {
+		return diags
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.checkedCount[kind]++
+	s.missingCount[kind]++
+
+	if s.maxMissingFraction >= 1 {
+		return diags
+	}
+
+	checked, missing := s.checkedCount[kind], s.missingCount[kind]
+	if checked < safeguardMinChecked {
+		if missing < checked {
+			return diags
+		}
+
+		hasItems, err := s.serverHasItems(ctx)
+		if err != nil {
+			diags.AddError("Mass Deletion Safeguard",
+				fmt.Sprintf("The %s %s is missing, but the items of the server could not be read to verify the "+
+					"endpoint, got error: %s", kind, id, err))
+		} else if !hasItems {
+			diags.AddError("Mass Deletion Safeguard",
+				fmt.Sprintf("The %s %s is missing and the openHAB server has no items at all. This usually means the "+
+					"endpoint points to a fresh or wrong openHAB server, so the %s is kept in state. If everything was "+
+					"deleted on purpose, set max_missing_fraction of the provider to 1.", kind, id, kind))
+		}
+
+		return diags
+	}
+
+	if float64(missing)/float64(checked) <= s.maxMissingFraction {
+		return diags
+	}
+
+	diags.AddError("Mass Deletion Safeguard",
+		fmt.Sprintf("The %s %s is missing, like %d of %d %ss read so far. This usually means the endpoint points "+
+			"to the wrong openHAB server, so the %s is kept in state. If the %ss were deleted on purpose, increase "+
+			"max_missing_fraction of the provider.", kind, id, missing, checked, kind, kind, kind))
+
+	return diags
+}
+
+// serverHasItems returns whether the server has any item, the result is requested once. Without a client, e.g. in
+// tests, the server is assumed to have items.
+func (s *safeguard) serverHasItems(ctx context.Context) (bool, error) {
+	if s.hasItems != nil {
+		return *s.hasItems, nil
+	}
+	if s.client == nil {
+		return true, nil
+	}
+
+	fields := "name"
+	apiResp, err := s.client.GetItems(ctx, &api.GetItemsParams{Fields: &fields})
+	if err != nil {
+		return false, err
+	}
+	if apiResp.StatusCode != 200 {
+		return false, api.ReadResponseError(apiResp)
+	}
+
+	var items []api.EnrichedItemDTO
+	if err := api.ReadResponseBody(apiResp, &items); err != nil {
+		return false, err
+	}
+
+	hasItems := len(items) > 0
+	s.hasItems = &hasItems
+
+	return hasItems, nil
+}
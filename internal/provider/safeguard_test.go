@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testItemReadWithSafeguard reads the test item using the given safeguard and returns the response.
+func testItemReadWithSafeguard(t *testing.T, s *safeguard, prior itemResourceData, status int, body string) *tfsdk.ReadResourceResponse {
+	ctx := context.Background()
+
+	schema, diags := ItemResourceType{}.GetSchema(ctx)
+	if diags.HasError() {
+		t.Fatalf("unable to get schema: %v", diags)
+	}
+
+	r := itemResource{itemRegistry: itemRegistry{client: testServer(t, "/items/test_item", status, body), safeguard: s}}
+
+	req := tfsdk.ReadResourceRequest{State: testState(t, schema, &prior)}
+	resp := &tfsdk.ReadResourceResponse{State: req.State}
+	r.Read(ctx, req, resp)
+
+	return resp
+}
+
+func TestSafeguardKeepsItemsIfTooManyAreMissing(t *testing.T) {
+	s := newSafeguard(nil, "server-uuid", 0.5)
+	for i := 0; i < safeguardMinChecked-1; i++ {
+		if diags := s.missing(context.Background(), "item", fmt.Sprintf("item_%d", i)); diags.HasError() {
+			t.Fatalf("expected no error before enough items were read, got %v", diags)
+		}
+	}
+
+	resp := testItemReadWithSafeguard(t, s, testItemData(), http.StatusNotFound, "")
+	if !resp.Diagnostics.HasError() {
+		t.Fatalf("expected error if all items are missing")
+	}
+	if resp.State.Raw.IsNull() {
+		t.Errorf("expected item to be kept in state")
+	}
+}
+
+func TestSafeguardRemovesSingleMissingItem(t *testing.T) {
+	s := newSafeguard(nil, "server-uuid", 0.5)
+	for i := 0; i < safeguardMinChecked; i++ {
+		s.found("item")
+	}
+
+	resp := testItemReadWithSafeguard(t, s, testItemData(), http.StatusNotFound, "")
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error reading item: %v", resp.Diagnostics)
+	}
+	if !resp.State.Raw.IsNull() {
+		t.Errorf("expected missing item to be removed from state")
+	}
+}
+
+func TestSafeguardDetectsServerMismatch(t *testing.T) {
+	prior := testItemData()
+	prior.ServerUuid = types.String{Value: "other-server-uuid"}
+
+	resp := testItemReadWithSafeguard(t, newSafeguard(nil, "server-uuid", 0.5), prior, http.StatusNotFound, "")
+	if !resp.Diagnostics.HasError() {
+		t.Fatalf("expected error if item was created on another server")
+	}
+	if resp.State.Raw.IsNull() {
+		t.Errorf("expected item to be kept in state")
+	}
+}
+
+func TestSafeguardRecordsServerUuid(t *testing.T) {
+	resp := testItemReadWithSafeguard(t, newSafeguard(nil, "server-uuid", 0.5), testItemData(), http.StatusOK, `{
+		"name": "test_item",
+		"type": "Number",
+		"label": "Test Number",
+		"category": "energy",
+		"tags": ["tag1", "tag2"]
+	}`)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error reading item: %v", resp.Diagnostics)
+	}
+
+	var result itemResourceData
+	if diags := resp.State.Get(context.Background(), &result); diags.HasError() {
+		t.Fatalf("unable to get state: %v", diags)
+	}
+	if result.ServerUuid.Value != "server-uuid" {
+		t.Errorf("expected server UUID to be recorded, got %v", result.ServerUuid)
+	}
+}
+
+func TestSafeguardKeepsFirstMissingItemsOfEmptyServer(t *testing.T) {
+	ctx := context.Background()
+
+	s := newSafeguard(testServer(t, "/items", http.StatusOK, `[]`), "server-uuid", 0.5)
+	for i := 0; i < 3; i++ {
+		if diags := s.missing(ctx, "item", fmt.Sprintf("item_%d", i)); !diags.HasError() {
+			t.Errorf("expected error if the server has no items")
+		}
+	}
+
+	// a found resource proves the endpoint, the server is not asked again
+	s.found("link")
+	if diags := s.missing(ctx, "link", "test_item-astro:sun:home:rise#start"); diags.HasError() {
+		t.Errorf("unexpected error after a link was found: %v", diags)
+	}
+}
+
+func TestSafeguardKeepsItemMetadataOfEmptyServer(t *testing.T) {
+	ctx := context.Background()
+
+	schema, diags := ItemMetadataResourceType{}.GetSchema(ctx)
+	if diags.HasError() {
+		t.Fatalf("unable to get schema: %v", diags)
+	}
+
+	// the item of the metadata is missing, because the server has no items at all
+	client := testServer(t, "/items", http.StatusOK, `[]`)
+	r := itemMetadataResource{client: client, safeguard: newSafeguard(client, "server-uuid", 0.5)}
+
+	data := testItemMetadataData()
+	req := tfsdk.ReadResourceRequest{State: testState(t, schema, &data)}
+	resp := &tfsdk.ReadResourceResponse{State: req.State}
+	r.Read(ctx, req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatalf("expected error if the server has no items")
+	}
+	if resp.State.Raw.IsNull() {
+		t.Errorf("expected item metadata to be kept in state")
+	}
+}
+
+func TestSafeguardRemovesFirstMissingItemsOfOtherServer(t *testing.T) {
+	ctx := context.Background()
+
+	s := newSafeguard(testServer(t, "/items", http.StatusOK, `[{"name": "other_item"}]`), "server-uuid", 0.5)
+	for i := 0; i < 3; i++ {
+		if diags := s.missing(ctx, "item", fmt.Sprintf("item_%d", i)); diags.HasError() {
+			t.Errorf("unexpected error if the server has items: %v", diags)
+		}
+	}
+
+	// the check is disabled completely
+	s = newSafeguard(testServer(t, "/items", http.StatusOK, `[]`), "server-uuid", 1)
+	if diags := s.missing(ctx, "item", "item_0"); diags.HasError() {
+		t.Errorf("unexpected error if the safeguard is disabled: %v", diags)
+	}
+}
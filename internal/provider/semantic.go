@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/chris922/terraform-provider-openhab/internal/api"
+	"github.com/chris922/terraform-provider-openhab/internal/provider/util"
+	"github.com/chris922/terraform-provider-openhab/internal/provider/validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// semantic classes an item of the given semantic class can be a member of, e.g. equipment is placed in a location
+// or is part of another equipment
+var semanticParentRoots = map[string][]string{
+	validator.SemanticLocation:  {validator.SemanticLocation},
+	validator.SemanticEquipment: {validator.SemanticLocation, validator.SemanticEquipment},
+	validator.SemanticPoint:     {validator.SemanticLocation, validator.SemanticEquipment},
+}
+
+// characters that can not be part of an item name
+var nonItemNamePattern = regexp.MustCompile(`[^a-zA-Z0-9]+`)
+
+var umlautReplacer = strings.NewReplacer("ä", "ae", "ö", "oe", "ü", "ue", "Ä", "Ae", "Ö", "Oe", "Ü", "Ue", "ß", "ss")
+
+// semanticClass returns the location, equipment or point root tag of the given tags, it is empty if the tags do not
+// contain a semantic class.
+func semanticClass(tags []string) string {
+	for _, tag := range tags {
+		if root := validator.SemanticTagRoot(tag); root != "" && root != validator.SemanticProperty {
+			return root
+		}
+	}
+
+	return ""
+}
+
+// checkSemanticParent reads the given group and checks if an item of the given semantic class can be its member.
+// If the parent is required, it has to exist and to be part of the semantic model, otherwise missing groups and
+// groups outside of the semantic model are accepted.
+func checkSemanticParent(ctx context.Context, client *api.Client, class string, parentName string,
+	required bool) diag.Diagnostics {
+	parentClass, found, diags := readSemanticClass(ctx, client, parentName)
+	if diags.HasError() {
+		return diags
+	}
+
+	if !found {
+		if required {
+			diags.AddError("Invalid Semantic Model",
+				fmt.Sprintf("The parent %s of the %s does not exist.", parentName, strings.ToLower(class)))
+		}
+		return diags
+	}
+
+	if parentClass == "" && !required {
+		return diags
+	}
+
+	allowed := semanticParentRoots[class]
+	if !util.StringArrayContains(allowed, parentClass) {
+		actual := "not part of the semantic model"
+		if parentClass != "" {
+			actual = "a " + strings.ToLower(parentClass)
+		}
+
+		diags.AddError("Invalid Semantic Model",
+			fmt.Sprintf("A %s can only be a member of a %s, but %s is %s.", strings.ToLower(class),
+				strings.ToLower(strings.Join(allowed, " or ")), parentName, actual))
+	}
+
+	return diags
+}
+
+// findSemanticParent returns the first of the given groups an item of the given semantic class can be a member of,
+// e.g. the location of an equipment. It is null if none of the groups is part of the semantic model.
+func findSemanticParent(ctx context.Context, client *api.Client, class string, groupNames *[]string) (types.String, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if groupNames == nil {
+		return types.String{Null: true}, diags
+	}
+
+	for _, groupName := range *groupNames {
+		groupClass, found, groupDiags := readSemanticClass(ctx, client, groupName)
+		diags.Append(groupDiags...)
+		if diags.HasError() {
+			return types.String{Null: true}, diags
+		}
+
+		if found && util.StringArrayContains(semanticParentRoots[class], groupClass) {
+			return types.String{Value: groupName}, diags
+		}
+	}
+
+	return types.String{Null: true}, diags
+}
+
+// readSemanticClass reads the given group and returns its semantic class, it is empty if the group is not part of the
+// semantic model. Found is false if the group does not exist.
+func readSemanticClass(ctx context.Context, client *api.Client, groupName string) (class string, found bool, diags diag.Diagnostics) {
+	apiResp, err := client.GetItemByName(ctx, groupName, &api.GetItemByNameParams{})
+	if err != nil {
+		diags.AddError("Validate Semantic Model Error",
+			fmt.Sprintf("Unable to read group %s, got error: %s", groupName, err))
+		return "", false, diags
+	}
+
+	if apiResp.StatusCode == 404 {
+		return "", false, diags
+	}
+	if apiResp.StatusCode != 200 {
+		diags.AddError("Validate Semantic Model Error",
+			fmt.Sprintf("Unable to read group %s, got error: %s", groupName, api.ReadResponseError(apiResp)))
+		return "", false, diags
+	}
+
+	group := &api.EnrichedItemDTO{}
+	if err := api.ReadResponseBody(apiResp, group); err != nil {
+		diags.AddError("Validate Semantic Model Error",
+			fmt.Sprintf("Unable to read response of reading group %s, got error: %s", groupName, err))
+		return "", false, diags
+	}
+
+	var tags []string
+	if group.Tags != nil {
+		tags = *group.Tags
+	}
+
+	return semanticClass(tags), true, diags
+}
+
+// semanticItemName derives an item name from the label of a location or equipment, e.g. `GroundFloor_LivingRoom`
+// for the label `Living Room` and the parent `GroundFloor`. It is empty if the label contains no usable characters.
+func semanticItemName(label string, parent string) string {
+	var name strings.Builder
+	for _, word := range nonItemNamePattern.Split(umlautReplacer.Replace(label), -1) {
+		if word == "" {
+			continue
+		}
+
+		runes := []rune(word)
+		runes[0] = unicode.ToUpper(runes[0])
+		name.WriteString(string(runes))
+	}
+	if name.Len() == 0 {
+		return ""
+	}
+
+	result := name.String()
+	if parent != "" {
+		result = parent + "_" + result
+	} else if unicode.IsDigit(rune(result[0])) {
+		result = "_" + result
+	}
+
+	return result
+}
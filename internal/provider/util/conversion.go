@@ -1,8 +1,11 @@
 package util
 
 import (
+	"encoding/json"
+	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
 )
 
 func StringToType(v *string) types.String {
@@ -60,7 +63,8 @@ func TypeToStringArray(v types.List) *[]string {
 func StringMapToType(v *map[string]string) types.Map {
 	if v == nil {
 		return types.Map{
-			Null: true,
+			ElemType: types.StringType,
+			Null:     true,
 		}
 	}
 
@@ -87,3 +91,169 @@ func TypeToStringMap(v types.Map) *map[string]string {
 
 	return &va
 }
+
+// InterfaceMapToType converts a configuration map as returned by openHAB into a map of strings. Non-string values
+// like numbers or booleans are formatted, lists and objects are JSON encoded.
+func InterfaceMapToType(v *map[string]interface{}) types.Map {
+	if v == nil {
+		return types.Map{
+			ElemType: types.StringType,
+			Null:     true,
+		}
+	}
+
+	vTyped := make(map[string]attr.Value, len(*v))
+	for k, v2 := range *v {
+		s := InterfaceToString(v2)
+		vTyped[k] = StringToType(&s)
+	}
+
+	return types.Map{
+		ElemType: types.StringType,
+		Elems:    vTyped,
+	}
+}
+
+// TypeToInterfaceMap converts a map of strings into a configuration map that can be sent to openHAB. The values are
+// kept as strings, openHAB normalizes them based on the related config description.
+func TypeToInterfaceMap(v types.Map) *map[string]interface{} {
+	if v.Unknown || v.Null {
+		return nil
+	}
+
+	va := make(map[string]interface{}, len(v.Elems))
+	for k, v2 := range v.Elems {
+		va[k] = *TypeToString(v2.(types.String))
+	}
+
+	return &va
+}
+
+// InterfaceToString formats a single JSON value as a string.
+func InterfaceToString(v interface{}) string {
+	switch vt := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return vt
+	case bool:
+		return strconv.FormatBool(vt)
+	case float64:
+		return strconv.FormatFloat(vt, 'f', -1, 64)
+	default:
+		b, err := json.Marshal(vt)
+		if err != nil {
+			return fmt.Sprintf("%v", vt)
+		}
+		return string(b)
+	}
+}
+
+// NormalizeStringArrayToType converts a list returned by openHAB like StringArrayToType, but keeps the prior value if
+// it contains the same elements in a different order. openHAB does not keep the order of e.g. tags. Missing and empty
+// lists are treated equally, the prior value decides whether the result is null or empty.
+func NormalizeStringArrayToType(prior types.List, v *[]string) types.List {
+	if v == nil || len(*v) == 0 {
+		if prior.Null || prior.Unknown {
+			return StringArrayToType(nil)
+		}
+
+		return types.List{
+			ElemType: types.StringType,
+			Elems:    []attr.Value{},
+		}
+	}
+
+	if !prior.Null && !prior.Unknown && len(prior.Elems) == len(*v) {
+		remaining := make(map[string]int, len(*v))
+		for _, v2 := range *v {
+			remaining[v2]++
+		}
+
+		sameElements := true
+		for _, v2 := range prior.Elems {
+			s := v2.(types.String)
+			if s.Unknown || s.Null || remaining[s.Value] == 0 {
+				sameElements = false
+				break
+			}
+			remaining[s.Value]--
+		}
+
+		if sameElements {
+			return prior
+		}
+	}
+
+	return StringArrayToType(v)
+}
+
+// MergeStringArrays returns the given values followed by all defaults that are not part of them. The result is nil if
+// there are neither values nor defaults.
+func MergeStringArrays(v *[]string, defaults []string) *[]string {
+	if len(defaults) == 0 {
+		return v
+	}
+
+	var merged []string
+	if v != nil {
+		merged = append(merged, *v...)
+	}
+	for _, d := range defaults {
+		if !StringArrayContains(merged, d) {
+			merged = append(merged, d)
+		}
+	}
+
+	return &merged
+}
+
+// RemoveStringArrayDefaults reverts MergeStringArrays for a list returned by openHAB. Defaults are only removed if
+// the prior value does not contain them, so a default that is also configured explicitly is kept.
+func RemoveStringArrayDefaults(prior types.List, v *[]string, defaults []string) *[]string {
+	if v == nil || len(defaults) == 0 {
+		return v
+	}
+
+	explicit := TypeToStringArray(prior)
+
+	var result []string
+	for _, v2 := range *v {
+		if StringArrayContains(defaults, v2) && (explicit == nil || !StringArrayContains(*explicit, v2)) {
+			continue
+		}
+		result = append(result, v2)
+	}
+
+	return &result
+}
+
+// NormalizeInterfaceMapToType converts a configuration map returned by openHAB like InterfaceMapToType, but keeps
+// prior values that are semantically equal, e.g. `"0"` and `0`, see JsonSemanticallyEqual. Missing and empty maps are
+// treated equally, the prior value decides whether the result is null or empty.
+func NormalizeInterfaceMapToType(prior types.Map, v *map[string]interface{}) types.Map {
+	if v == nil || len(*v) == 0 {
+		if prior.Null || prior.Unknown {
+			return InterfaceMapToType(nil)
+		}
+
+		return types.Map{
+			ElemType: types.StringType,
+			Elems:    map[string]attr.Value{},
+		}
+	}
+
+	result := InterfaceMapToType(v)
+	if prior.Null || prior.Unknown {
+		return result
+	}
+
+	for k, v2 := range *v {
+		if priorValue, ok := prior.Elems[k].(types.String); ok && !priorValue.Null && !priorValue.Unknown &&
+			JsonSemanticallyEqual(priorValue.Value, v2) {
+			result.Elems[k] = priorValue
+		}
+	}
+
+	return result
+}
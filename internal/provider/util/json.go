@@ -0,0 +1,111 @@
+package util
+
+import (
+	"encoding/json"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"reflect"
+	"strconv"
+)
+
+// JsonToInterfaceMap decodes a JSON encoded object, e.g. created by `jsonencode`, into a map that can be sent to
+// openHAB.
+func JsonToInterfaceMap(v types.String) (*map[string]interface{}, error) {
+	if v.Unknown || v.Null {
+		return nil, nil
+	}
+
+	va := make(map[string]interface{})
+	err := json.Unmarshal([]byte(v.Value), &va)
+	if err != nil {
+		return nil, err
+	}
+
+	return &va, nil
+}
+
+// InterfaceMapToJson encodes the given map as JSON. If the result is semantically equal to the prior value, the prior
+// value is returned to keep its formatting and avoid differences between e.g. `"0"` and `0`. An empty map is treated
+// like a missing one if the prior value is null.
+func InterfaceMapToJson(v *map[string]interface{}, prior types.String) (types.String, error) {
+	if v == nil || (len(*v) == 0 && prior.Null) {
+		return types.String{Null: true}, nil
+	}
+
+	if !prior.Null && !prior.Unknown {
+		var priorValue map[string]interface{}
+		if err := json.Unmarshal([]byte(prior.Value), &priorValue); err == nil &&
+			JsonSemanticallyEqual(priorValue, *v) {
+			return prior, nil
+		}
+	}
+
+	b, err := json.Marshal(*v)
+	if err != nil {
+		return types.String{Null: true}, err
+	}
+
+	return types.String{Value: string(b)}, nil
+}
+
+// JsonSemanticallyEqual compares two decoded JSON values. Scalar values are compared by their string representation,
+// because openHAB converts configuration values based on config descriptions, e.g. `"5"` becomes `5`. Numbers are
+// compared numerically, so `"1.0"` and `1` are equal as well.
+func JsonSemanticallyEqual(a interface{}, b interface{}) bool {
+	switch at := a.(type) {
+	case map[string]interface{}:
+		bt, ok := b.(map[string]interface{})
+		if !ok || len(at) != len(bt) {
+			return false
+		}
+		for k, av := range at {
+			bv, ok := bt[k]
+			if !ok || !JsonSemanticallyEqual(av, bv) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		bt, ok := b.([]interface{})
+		if !ok || len(at) != len(bt) {
+			return false
+		}
+		for i := range at {
+			if !JsonSemanticallyEqual(at[i], bt[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		switch b.(type) {
+		case map[string]interface{}, []interface{}:
+			return false
+		}
+		if a == nil || b == nil {
+			return reflect.DeepEqual(a, b)
+		}
+		if InterfaceToString(a) == InterfaceToString(b) {
+			return true
+		}
+		return numbersEqual(a, b)
+	}
+}
+
+// numbersEqual compares two scalar values numerically if at least one of them is a number, e.g. `"1.0"` and `1`.
+func numbersEqual(a interface{}, b interface{}) bool {
+	_, aIsNumber := a.(float64)
+	_, bIsNumber := b.(float64)
+	if !aIsNumber && !bIsNumber {
+		return false
+	}
+
+	af, err := strconv.ParseFloat(InterfaceToString(a), 64)
+	if err != nil {
+		return false
+	}
+	bf, err := strconv.ParseFloat(InterfaceToString(b), 64)
+	if err != nil {
+		return false
+	}
+
+	return af == bf
+}
@@ -0,0 +1,51 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+)
+
+type apiTokenValidator struct {
+	tfsdk.AttributeValidator
+}
+
+func ApiTokenValidator() *apiTokenValidator {
+	return &apiTokenValidator{}
+}
+
+func (v apiTokenValidator) Description(ctx context.Context) string {
+	return "Ensures a given API token has the format of an openHAB API token."
+}
+
+func (v apiTokenValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v apiTokenValidator) Validate(_ context.Context, request tfsdk.ValidateAttributeRequest, response *tfsdk.ValidateAttributeResponse) {
+	value := request.AttributeConfig.(types.String)
+	if value.Null || value.Unknown {
+		return
+	}
+
+	if err := ValidateApiToken(value.Value); err != nil {
+		response.Diagnostics.AddAttributeError(request.AttributePath, "Invalid API token", err.Error())
+	}
+}
+
+// ValidateApiToken checks if the given token looks like an API token created in openHAB, these have the format
+// `oh.<name>.<secret>`. The token itself is not part of the error to avoid leaking it.
+func ValidateApiToken(token string) error {
+	parts := strings.SplitN(token, ".", 3)
+	if len(parts) != 3 || parts[0] != "oh" || parts[1] == "" || parts[2] == "" {
+		return fmt.Errorf("given API token does not have the format 'oh.<name>.<secret>' of openHAB API tokens")
+	}
+
+	if strings.ContainsAny(token, " \t\r\n") {
+		return fmt.Errorf("given API token must not contain whitespace")
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package validator
+
+import (
+	"errors"
+	"fmt"
+	"github.com/chris922/terraform-provider-openhab/internal/provider/util"
+	"sort"
+	"strings"
+)
+
+// ErrUnknownUnit is returned for units that are not part of the catalogue, openHAB may still know them.
+var ErrUnknownUnit = errors.New("unknown unit")
+
+// dimension lists the unit symbols of a dimension of `Number` items.
+type dimension struct {
+	// units accepting SI prefixes, e.g. `W` for `kW` or `mW`
+	prefixable []string
+	// units used as they are
+	units []string
+	// units of the dimension are not restricted, e.g. currency codes
+	anyUnit bool
+}
+
+// SI prefixes, `µ` is also accepted as `u` by openHAB
+var siPrefixes = []string{"Y", "Z", "E", "P", "T", "G", "M", "k", "h", "da", "d", "c", "m", "µ", "u", "n", "p", "f",
+	"a", "z", "y"}
+
+// binary prefixes of data amounts
+var binaryPrefixes = []string{"Ki", "Mi", "Gi", "Ti", "Pi", "Ei"}
+
+// see https://www.openhab.org/docs/concepts/units-of-measurement.html#list-of-units
+var dimensions = map[string]dimension{
+	"Acceleration":           {units: []string{"m/s²", "gₙ"}},
+	"AmountOfSubstance":      {prefixable: []string{"mol"}},
+	"Angle":                  {prefixable: []string{"rad"}, units: []string{"°", "'", "''"}},
+	"Area":                   {prefixable: []string{"m²"}, units: []string{"in²", "ft²", "yd²", "ac", "mi²"}},
+	"ArealDensity":           {units: []string{"DU"}},
+	"CatalyticActivity":      {prefixable: []string{"kat"}},
+	"Currency":               {anyUnit: true},
+	"DataAmount":             {prefixable: []string{"bit", "B"}},
+	"DataTransferRate":       {prefixable: []string{"bit/s", "B/s"}},
+	"Density":                {prefixable: []string{"g/m³"}},
+	"Dimensionless":          {units: []string{"%", "‰", "ppm", "ppb", "ppt", "dB", "one"}},
+	"ElectricCapacitance":    {prefixable: []string{"F"}},
+	"ElectricCharge":         {prefixable: []string{"C", "Ah"}},
+	"ElectricConductance":    {prefixable: []string{"S"}},
+	"ElectricConductivity":   {prefixable: []string{"S/m"}},
+	"ElectricCurrent":        {prefixable: []string{"A"}},
+	"ElectricInductance":     {prefixable: []string{"H"}},
+	"ElectricPotential":      {prefixable: []string{"V"}},
+	"ElectricResistance":     {prefixable: []string{"Ω"}},
+	"Energy":                 {prefixable: []string{"J", "Wh", "VAh", "varh"}, units: []string{"cal", "kcal", "BTU"}},
+	"EnergyPrice":            {anyUnit: true},
+	"Force":                  {prefixable: []string{"N"}},
+	"Frequency":              {prefixable: []string{"Hz"}, units: []string{"rpm"}},
+	"Illuminance":            {prefixable: []string{"lx"}},
+	"Intensity":              {prefixable: []string{"W/m²"}, units: []string{"µW/cm²"}},
+	"Length":                 {prefixable: []string{"m"}, units: []string{"in", "ft", "yd", "ch", "fur", "mi", "lea"}},
+	"LuminousFlux":           {prefixable: []string{"lm"}},
+	"LuminousIntensity":      {prefixable: []string{"cd"}},
+	"MagneticFlux":           {prefixable: []string{"Wb"}},
+	"MagneticFluxDensity":    {prefixable: []string{"T"}, units: []string{"G"}},
+	"Mass":                   {prefixable: []string{"g"}, units: []string{"t", "lb", "oz"}},
+	"Power":                  {prefixable: []string{"W", "VA", "var"}, units: []string{"dBm", "hp"}},
+	"Pressure":               {prefixable: []string{"Pa", "bar"}, units: []string{"inHg", "mmHg", "psi"}},
+	"RadiationDoseAbsorbed":  {prefixable: []string{"Gy"}},
+	"RadiationDoseEffective": {prefixable: []string{"Sv"}},
+	"Radioactivity":          {prefixable: []string{"Bq", "Ci"}},
+	"SolidAngle":             {prefixable: []string{"sr"}},
+	"Speed":                  {units: []string{"m/s", "km/h", "mm/h", "in/h", "mph", "kn", "Bft"}},
+	"Temperature":            {prefixable: []string{"K"}, units: []string{"°C", "°F", "mired"}},
+	"Time":                   {prefixable: []string{"s"}, units: []string{"min", "h", "d", "week", "y"}},
+	"Volume":                 {prefixable: []string{"m³", "l", "L"}, units: []string{"gal"}},
+	"VolumetricFlowRate":     {units: []string{"l/min", "l/h", "m³/s", "m³/min", "m³/h", "m³/d", "gal/min"}},
+}
+
+// IsKnownDimension returns true if the given dimension can be used for `Number` items.
+func IsKnownDimension(dimension string) bool {
+	_, ok := dimensions[dimension]
+	return ok
+}
+
+// ValidateUnit checks if the given unit symbol can be used for the given dimension. Units that are part of another
+// dimension are an error, units missing in the catalogue return ErrUnknownUnit.
+func ValidateUnit(dimensionName string, unit string) error {
+	d, ok := dimensions[dimensionName]
+	if !ok {
+		return fmt.Errorf("dimension %s is unknown", dimensionName)
+	}
+	if d.anyUnit || d.hasUnit(unit) {
+		return nil
+	}
+
+	var unitDimensions []string
+	for name, other := range dimensions {
+		if other.hasUnit(unit) {
+			unitDimensions = append(unitDimensions, name)
+		}
+	}
+	if len(unitDimensions) > 0 {
+		sort.Strings(unitDimensions)
+		return fmt.Errorf("unit %s is a unit of %s, not of %s", unit, strings.Join(unitDimensions, ", "), dimensionName)
+	}
+
+	return fmt.Errorf("%w %s for dimension %s", ErrUnknownUnit, unit, dimensionName)
+}
+
+func (d dimension) hasUnit(unit string) bool {
+	if util.StringArrayContains(d.units, unit) || util.StringArrayContains(d.prefixable, unit) {
+		return true
+	}
+
+	for _, prefixable := range d.prefixable {
+		if !strings.HasSuffix(unit, prefixable) {
+			continue
+		}
+
+		prefix := strings.TrimSuffix(unit, prefixable)
+		if util.StringArrayContains(siPrefixes, prefix) {
+			return true
+		}
+		// only data amounts use binary prefixes, e.g. `KiB`
+		if (prefixable == "B" || prefixable == "bit") && util.StringArrayContains(binaryPrefixes, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
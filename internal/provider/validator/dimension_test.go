@@ -0,0 +1,35 @@
+package validator
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidateUnit(t *testing.T) {
+	valid := map[string][]string{
+		"Power":         {"W", "kW", "mW", "VA", "kvar"},
+		"Energy":        {"Wh", "kWh", "MWh", "J"},
+		"Temperature":   {"°C", "°F", "K"},
+		"Pressure":      {"hPa", "mbar", "psi"},
+		"DataAmount":    {"B", "kB", "MiB", "Gbit"},
+		"Dimensionless": {"%", "ppm"},
+		"Currency":      {"EUR", "USD"},
+	}
+	for dimension, units := range valid {
+		for _, unit := range units {
+			if err := ValidateUnit(dimension, unit); err != nil {
+				t.Errorf("expected unit %s to be valid for %s, got %s", unit, dimension, err)
+			}
+		}
+	}
+
+	if err := ValidateUnit("Power", "kWh"); err == nil || errors.Is(err, ErrUnknownUnit) {
+		t.Errorf("expected unit of another dimension to be invalid, got %v", err)
+	}
+	if err := ValidateUnit("Power", "foo"); !errors.Is(err, ErrUnknownUnit) {
+		t.Errorf("expected unknown unit, got %v", err)
+	}
+	if err := ValidateUnit("Length", "MiB"); err == nil {
+		t.Errorf("expected binary prefix to be invalid for lengths")
+	}
+}
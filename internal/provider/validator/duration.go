@@ -0,0 +1,41 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"time"
+)
+
+type durationValidator struct {
+	tfsdk.AttributeValidator
+}
+
+func DurationValidator() *durationValidator {
+	return &durationValidator{}
+}
+
+func (v durationValidator) Description(ctx context.Context) string {
+	return "Ensures a given duration is valid and not negative."
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v durationValidator) Validate(_ context.Context, request tfsdk.ValidateAttributeRequest, response *tfsdk.ValidateAttributeResponse) {
+	value := request.AttributeConfig.(types.String)
+	if value.Null || value.Unknown {
+		return
+	}
+
+	d, err := time.ParseDuration(value.Value)
+	if err != nil {
+		response.Diagnostics.AddAttributeError(request.AttributePath, "Invalid duration",
+			fmt.Sprintf("Given duration '%s' is invalid, expected e.g. '30s' or '5m': %s", value.Value, err))
+	} else if d < 0 {
+		response.Diagnostics.AddAttributeError(request.AttributePath, "Invalid duration",
+			fmt.Sprintf("Given duration '%s' must not be negative.", value.Value))
+	}
+}
@@ -0,0 +1,50 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"net/url"
+)
+
+type endpointValidator struct {
+	tfsdk.AttributeValidator
+}
+
+func EndpointValidator() *endpointValidator {
+	return &endpointValidator{}
+}
+
+func (v endpointValidator) Description(ctx context.Context) string {
+	return "Ensures a given endpoint is an absolute HTTP or HTTPS URL."
+}
+
+func (v endpointValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v endpointValidator) Validate(_ context.Context, request tfsdk.ValidateAttributeRequest, response *tfsdk.ValidateAttributeResponse) {
+	value := request.AttributeConfig.(types.String)
+	if value.Null || value.Unknown {
+		return
+	}
+
+	if err := ValidateEndpoint(value.Value); err != nil {
+		response.Diagnostics.AddAttributeError(request.AttributePath, "Invalid endpoint", err.Error())
+	}
+}
+
+// ValidateEndpoint checks if the given endpoint is an absolute HTTP or HTTPS URL, e.g. `http://openhab:8080/rest`.
+func ValidateEndpoint(endpoint string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return fmt.Errorf("given endpoint '%s' is not a valid URL: %s", endpoint, err)
+	}
+
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("given endpoint '%s' is not an absolute HTTP or HTTPS URL, e.g. http://openhab:8080/rest", endpoint)
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package validator
+
+import (
+	"fmt"
+	"github.com/chris922/terraform-provider-openhab/internal/provider/util"
+	"strings"
+)
+
+/**
+Available group functions are:
+	EQUALITY	Sets the state of the group to the state of the members if all have the same state, otherwise UNDEF
+	AND	Sets the state to the first param if all members have this state, otherwise to the second param
+	OR	Sets the state to the first param if at least one member has this state, otherwise to the second param
+	NAND	Negation of AND
+	NOR	Negation of OR
+	AVG	Average of all numeric member states
+	SUM	Sum of all numeric member states
+	MIN	Minimum of all numeric member states
+	MAX	Maximum of all numeric member states
+	COUNT	Number of members matching the regular expression given as param
+	LATEST	Latest date of all DateTime member states
+	EARLIEST	Earliest date of all DateTime member states
+
+See: https://www.openhab.org/docs/configuration/items.html#group-type-and-state
+*/
+
+// base types whose states can be aggregated arithmetically
+var numericBaseTypes = []string{
+	"Number",
+	"Dimmer",
+	"Rollershutter",
+}
+
+// states a group function param may have for the given base type, types not listed here are not checked
+var groupFunctionStates = map[string][]string{
+	"Switch":  {"ON", "OFF"},
+	"Contact": {"OPEN", "CLOSED"},
+}
+
+// ValidateGroupFunction checks if the function with the given params can be used to aggregate the states of a group
+// with the given base type. The group type may be empty if the group has no base type.
+func ValidateGroupFunction(groupType string, name string, params []string) error {
+	baseType := strings.Split(groupType, ":")[0]
+
+	switch name {
+	case "EQUALITY":
+		return expectParams(name, params, 0)
+	case "AND", "OR", "NAND", "NOR":
+		if err := expectParams(name, params, 2); err != nil {
+			return err
+		}
+		if baseType == "" {
+			return fmt.Errorf("function %s requires a group type", name)
+		}
+		if states, ok := groupFunctionStates[baseType]; ok {
+			for _, param := range params {
+				if !util.StringArrayContains(states, param) {
+					return fmt.Errorf("param '%s' of function %s is not a valid state of type %s, expected one of %s",
+						param, name, baseType, strings.Join(states, ", "))
+				}
+			}
+		}
+		return nil
+	case "AVG", "SUM", "MIN", "MAX":
+		if err := expectParams(name, params, 0); err != nil {
+			return err
+		}
+		if !util.StringArrayContains(numericBaseTypes, baseType) {
+			return fmt.Errorf("function %s requires a numeric group type (%s), got '%s'",
+				name, strings.Join(numericBaseTypes, ", "), groupType)
+		}
+		return nil
+	case "COUNT":
+		if err := expectParams(name, params, 1); err != nil {
+			return err
+		}
+		if baseType != "Number" {
+			return fmt.Errorf("function %s requires group type Number, got '%s'", name, groupType)
+		}
+		return nil
+	case "LATEST", "EARLIEST":
+		if err := expectParams(name, params, 0); err != nil {
+			return err
+		}
+		if baseType != "DateTime" {
+			return fmt.Errorf("function %s requires group type DateTime, got '%s'", name, groupType)
+		}
+		return nil
+	default:
+		return fmt.Errorf("function '%s' is unknown", name)
+	}
+}
+
+func expectParams(name string, params []string, count int) error {
+	if len(params) != count {
+		return fmt.Errorf("function %s requires %d params, got %d", name, count, len(params))
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package validator
+
+import "testing"
+
+func TestValidateGroupFunction(t *testing.T) {
+	tests := []struct {
+		groupType string
+		name      string
+		params    []string
+		valid     bool
+	}{
+		{groupType: "Switch", name: "EQUALITY", params: nil, valid: true},
+		{groupType: "Switch", name: "EQUALITY", params: []string{"ON"}, valid: false},
+
+		{groupType: "Switch", name: "AND", params: []string{"ON", "OFF"}, valid: true},
+		{groupType: "Switch", name: "OR", params: []string{"ON", "OFF"}, valid: true},
+		{groupType: "Contact", name: "NAND", params: []string{"OPEN", "CLOSED"}, valid: true},
+		{groupType: "String", name: "NOR", params: []string{"a", "b"}, valid: true},
+		{groupType: "Switch", name: "OR", params: []string{"ON"}, valid: false},
+		{groupType: "Switch", name: "AND", params: []string{"ON", "OFF", "ON"}, valid: false},
+		{groupType: "Switch", name: "OR", params: []string{"OPEN", "CLOSED"}, valid: false},
+		{groupType: "", name: "AND", params: []string{"ON", "OFF"}, valid: false},
+
+		{groupType: "Number:Power", name: "AVG", params: nil, valid: true},
+		{groupType: "Dimmer", name: "MAX", params: nil, valid: true},
+		{groupType: "Switch", name: "SUM", params: nil, valid: false},
+		{groupType: "Number", name: "MIN", params: []string{"1"}, valid: false},
+
+		{groupType: "Number", name: "COUNT", params: []string{"ON|OPEN"}, valid: true},
+		{groupType: "Number", name: "COUNT", params: nil, valid: false},
+		{groupType: "Number", name: "COUNT", params: []string{"ON", "OPEN"}, valid: false},
+		{groupType: "Switch", name: "COUNT", params: []string{"ON"}, valid: false},
+
+		{groupType: "DateTime", name: "LATEST", params: nil, valid: true},
+		{groupType: "Number", name: "EARLIEST", params: nil, valid: false},
+
+		{groupType: "Number", name: "MEDIAN", params: nil, valid: false},
+	}
+
+	for _, test := range tests {
+		err := ValidateGroupFunction(test.groupType, test.name, test.params)
+		if (err == nil) != test.valid {
+			t.Errorf("expected function %s%v of group type %q to be valid: %t, got error %v", test.name,
+				test.params, test.groupType, test.valid, err)
+		}
+	}
+}
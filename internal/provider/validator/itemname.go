@@ -0,0 +1,65 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"regexp"
+)
+
+// item names have to start with a letter or underscore, followed by letters, digits and underscores
+// see https://www.openhab.org/docs/configuration/items.html#name
+var itemNamePattern = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+type itemNameValidator struct {
+	tfsdk.AttributeValidator
+}
+
+// ItemNameValidator validates item names, it can be used for a single name or a list of names like `group_names`.
+func ItemNameValidator() *itemNameValidator {
+	return &itemNameValidator{}
+}
+
+func (v itemNameValidator) Description(ctx context.Context) string {
+	return "Ensures a given item name is valid."
+}
+
+func (v itemNameValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v itemNameValidator) Validate(_ context.Context, request tfsdk.ValidateAttributeRequest, response *tfsdk.ValidateAttributeResponse) {
+	switch value := request.AttributeConfig.(type) {
+	case types.String:
+		if value.Null || value.Unknown {
+			return
+		}
+
+		if err := ValidateItemName(value.Value); err != nil {
+			response.Diagnostics.AddAttributeError(request.AttributePath, "Invalid item name", err.Error())
+		}
+	case types.List:
+		for i, element := range value.Elems {
+			name, ok := element.(types.String)
+			if !ok || name.Null || name.Unknown {
+				continue
+			}
+
+			if err := ValidateItemName(name.Value); err != nil {
+				response.Diagnostics.AddAttributeError(request.AttributePath.WithElementKeyInt(i),
+					"Invalid item name", err.Error())
+			}
+		}
+	}
+}
+
+// ValidateItemName checks if openHAB accepts the given name for an item.
+func ValidateItemName(name string) error {
+	if !itemNamePattern.MatchString(name) {
+		return fmt.Errorf("given item name '%s' is invalid, it has to start with a letter or underscore and may "+
+			"only contain letters, digits and underscores", name)
+	}
+
+	return nil
+}
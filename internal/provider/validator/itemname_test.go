@@ -0,0 +1,22 @@
+package validator
+
+import "testing"
+
+func TestValidateItemName(t *testing.T) {
+	tests := map[string]bool{
+		"test_item":    true,
+		"_hidden":      true,
+		"Kitchen2":     true,
+		"2nd_floor":    false,
+		"living-room":  false,
+		"living room":  false,
+		"temperature°": false,
+		"":             false,
+	}
+
+	for name, valid := range tests {
+		if err := ValidateItemName(name); (err == nil) != valid {
+			t.Errorf("expected item name %q to be valid: %t, got error %v", name, valid, err)
+		}
+	}
+}
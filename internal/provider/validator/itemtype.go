@@ -3,7 +3,6 @@ package validator
 import (
 	"context"
 	"fmt"
-	"github.com/chris922/terraform-provider-openhab/internal/provider/util"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"strings"
@@ -43,63 +42,15 @@ Available Item types are:
 See: https://www.openhab.org/docs/concepts/items.html
 */
 
-// see https://www.openhab.org/docs/concepts/units-of-measurement.html#list-of-units
-var imperialUnits = []string{
-	"Pressure",
-	"Temperature",
-	"Speed",
-	"Length",
-}
-
-// see https://www.openhab.org/docs/concepts/units-of-measurement.html#list-of-units
-var siUnits = []string{
-	"Acceleration",
-	"AmountOfSubstance",
-	"Angle",
-	"Area",
-	"ArealDensity",
-	"CatalyticActivity",
-	"DataAmount",
-	"DataTransferRate",
-	"Density",
-	"Dimensionless",
-	"ElectricPotential",
-	"ElectricCapacitance",
-	"ElectricCharge",
-	"ElectricConductance",
-	"ElectricConductivity",
-	"ElectricCurrent",
-	"ElectricInductance",
-	"ElectricResistance",
-	"Energy",
-	"Force",
-	"Frequency",
-	"Illuminance",
-	"Intensity",
-	"Length",
-	"LuminousFlux",
-	"LuminousIntensity",
-	"MagneticFlux",
-	"MagneticFluxDensity",
-	"Mass",
-	"Power",
-	"Pressure",
-	"Radioactivity",
-	"RadiationDoseAbsorbed",
-	"RadiationDoseEffective",
-	"SolidAngle",
-	"Speed",
-	"Temperature",
-	"Time",
-	"Volume",
-	"VolumetricFlowRate",
-}
-
 func (v itemTypeValidator) Validate(_ context.Context, request tfsdk.ValidateAttributeRequest, response *tfsdk.ValidateAttributeResponse) {
-	fullValue := request.AttributeConfig.(types.String).Value
-	valueParts := strings.Split(fullValue, ":")
+	value := request.AttributeConfig.(types.String)
+	if value.Null || value.Unknown {
+		return
+	}
+
+	fullValue := value.Value
+	baseType, dimension := SplitItemType(fullValue)
 
-	baseType := valueParts[0]
 	switch baseType {
 	case "Color":
 	case "Contact":
@@ -114,9 +65,8 @@ func (v itemTypeValidator) Validate(_ context.Context, request tfsdk.ValidateAtt
 	case "Switch":
 		break
 	case "Number":
-		if len(valueParts) > 1 {
-			dimension := valueParts[1]
-			if !util.StringArrayContains(imperialUnits, dimension) && !util.StringArrayContains(siUnits, dimension) {
+		if strings.Contains(fullValue, ":") {
+			if !IsKnownDimension(dimension) {
 				response.Diagnostics.AddAttributeError(request.AttributePath,
 					"Unknown dimension used for Number type",
 					fmt.Sprintf("Given dimension '%s' is unknown.", dimension))
@@ -0,0 +1,47 @@
+package validator
+
+import (
+	"fmt"
+	"github.com/chris922/terraform-provider-openhab/internal/provider/util"
+	"strings"
+)
+
+// channel item types an item of the given base type can be linked to besides its own type, the item accepts the
+// states sent by these channels, e.g. a Dimmer item accepts the ON/OFF states of a Switch channel
+var compatibleChannelItemTypes = map[string][]string{
+	"Color":         {"Dimmer", "Switch"},
+	"Dimmer":        {"Switch"},
+	"Rollershutter": {"Dimmer"},
+	"String":        {"DateTime"},
+}
+
+// SplitItemType splits an item type like `Number:Temperature` into its base type and dimension, the dimension is
+// empty if the type has none.
+func SplitItemType(itemType string) (baseType string, dimension string) {
+	parts := strings.SplitN(itemType, ":", 2)
+	if len(parts) == 1 {
+		return parts[0], ""
+	}
+
+	return parts[0], parts[1]
+}
+
+// ValidateLinkItemType checks if an item of the given type can be linked to a state channel of the given item type.
+// Dimensions are only compared if both the item and the channel have one, openHAB converts plain numbers using the
+// default unit of the dimension.
+func ValidateLinkItemType(itemType string, channelItemType string) error {
+	itemBaseType, itemDimension := SplitItemType(itemType)
+	channelBaseType, channelDimension := SplitItemType(channelItemType)
+
+	if itemBaseType != channelBaseType &&
+		!util.StringArrayContains(compatibleChannelItemTypes[itemBaseType], channelBaseType) {
+		return fmt.Errorf("item type %s is not compatible with channel item type %s", itemType, channelItemType)
+	}
+
+	if itemBaseType == "Number" && itemDimension != "" && channelDimension != "" && itemDimension != channelDimension {
+		return fmt.Errorf("dimension %s of item type %s does not match dimension %s of channel item type %s",
+			itemDimension, itemType, channelDimension, channelItemType)
+	}
+
+	return nil
+}
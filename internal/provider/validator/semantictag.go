@@ -0,0 +1,177 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"sort"
+	"strings"
+)
+
+// root tags of the semantic model, every semantic tag belongs to exactly one of them
+const (
+	SemanticLocation  = "Location"
+	SemanticEquipment = "Equipment"
+	SemanticPoint     = "Point"
+	SemanticProperty  = "Property"
+)
+
+// semantic tags by root tag, the root tags are tags themselves
+// see https://github.com/openhab/openhab-core/blob/main/bundles/org.openhab.core.semantics/model/SemanticTags.csv
+var semanticTags = map[string][]string{
+	SemanticLocation: {
+		"Location",
+		"Indoor", "Apartment", "Building", "Garage", "House", "Shed", "SummerHouse",
+		"Floor", "GroundFloor", "FirstFloor", "SecondFloor", "ThirdFloor", "Attic", "Basement",
+		"Corridor", "Room", "Bathroom", "Bedroom", "BoilerRoom", "Cellar", "DiningRoom", "Entry", "FamilyRoom",
+		"GuestRoom", "Kitchen", "LaundryRoom", "LivingRoom", "Office", "Veranda",
+		"Outdoor", "Carport", "Driveway", "Garden", "Patio", "Porch", "Terrace",
+	},
+	SemanticEquipment: {
+		"Equipment",
+		"AlarmSystem", "Battery", "Blinds", "Boiler", "Camera", "Car", "CleaningRobot",
+		"Door", "BackDoor", "CellarDoor", "FrontDoor", "GarageDoor", "Gate", "InnerDoor", "SideDoor",
+		"Doorbell", "Fan", "CeilingFan", "KitchenHood", "HVAC", "Inverter", "LawnMower", "Lightbulb", "LightStripe",
+		"Lock", "NetworkAppliance", "PowerOutlet", "Projector", "Pump", "RadiatorControl", "Receiver",
+		"RemoteControl", "Screen", "Television", "Sensor", "MotionDetector", "SmokeDetector", "Siren", "Smartphone",
+		"Speaker", "Valve", "VoiceAssistant", "WallSwitch", "WebService", "WeatherService",
+		"WhiteGood", "Dishwasher", "Dryer", "Freezer", "Oven", "Refrigerator", "WashingMachine", "Window",
+	},
+	SemanticPoint: {
+		"Point",
+		"Alarm", "Control", "Switch", "Measurement", "Setpoint",
+		"Status", "LowBattery", "OpenLevel", "OpenState", "Tampered", "Tilt",
+	},
+	SemanticProperty: {
+		"Property",
+		"Temperature", "Light", "ColorTemperature", "Humidity", "Presence", "Pressure", "Smoke", "Noise", "Rain",
+		"Wind", "Water", "CO2", "CO", "Energy", "Power", "Voltage", "Current", "Frequency", "Gas", "SoundVolume",
+		"Oil", "Duration", "Level", "Opening", "Timestamp", "Ultraviolet", "Vibration",
+	},
+}
+
+// SemanticTagRoot returns the root tag of the given semantic tag, e.g. `Location` for `LivingRoom`. It is empty if
+// the tag is not part of the semantic model.
+func SemanticTagRoot(tag string) string {
+	for root, tags := range semanticTags {
+		for _, semanticTag := range tags {
+			if semanticTag == tag {
+				return root
+			}
+		}
+	}
+
+	return ""
+}
+
+type semanticTagsValidator struct {
+	tfsdk.AttributeValidator
+}
+
+func SemanticTagsValidator() *semanticTagsValidator {
+	return &semanticTagsValidator{}
+}
+
+func (v semanticTagsValidator) Description(ctx context.Context) string {
+	return "Ensures the semantic tags of an item are consistent."
+}
+
+func (v semanticTagsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v semanticTagsValidator) Validate(_ context.Context, request tfsdk.ValidateAttributeRequest, response *tfsdk.ValidateAttributeResponse) {
+	value := request.AttributeConfig.(types.List)
+	if value.Null || value.Unknown {
+		return
+	}
+
+	var tags []string
+	for i, element := range value.Elems {
+		tag, ok := element.(types.String)
+		if !ok || tag.Unknown {
+			return
+		}
+		if tag.Null {
+			continue
+		}
+
+		if SemanticTagRoot(tag.Value) == "" {
+			if suggestion := similarSemanticTag(tag.Value); suggestion != "" {
+				response.Diagnostics.AddAttributeWarning(request.AttributePath.WithElementKeyInt(i),
+					"Unknown semantic tag",
+					fmt.Sprintf("Given tag '%s' is not part of the semantic model, did you mean '%s'? Semantic tags "+
+						"are case sensitive.", tag.Value, suggestion))
+			}
+			continue
+		}
+
+		tags = append(tags, tag.Value)
+	}
+
+	if err := ValidateSemanticTags(tags); err != nil {
+		response.Diagnostics.AddAttributeError(request.AttributePath, "Invalid semantic tags", err.Error())
+		return
+	}
+
+	// other integrations use some property tags as well, e.g. `Temperature`, so this is no error
+	property := semanticTagOfRoot(tags, SemanticProperty)
+	if property != "" && semanticTagOfRoot(tags, SemanticPoint) == "" {
+		response.Diagnostics.AddAttributeWarning(request.AttributePath, "Incomplete semantic tags",
+			fmt.Sprintf("Property tag '%s' is only used by the semantic model together with a point tag.", property))
+	}
+}
+
+// ValidateSemanticTags checks if the given tags describe a valid semantic class. An item is either a location, an
+// equipment or a point and has at most one property. Tags outside of the semantic model are ignored.
+func ValidateSemanticTags(tags []string) error {
+	tagsByRoot := map[string][]string{}
+	for _, tag := range tags {
+		if root := SemanticTagRoot(tag); root != "" {
+			tagsByRoot[root] = append(tagsByRoot[root], tag)
+		}
+	}
+
+	var classTags []string
+	for _, root := range []string{SemanticLocation, SemanticEquipment, SemanticPoint} {
+		classTags = append(classTags, tagsByRoot[root]...)
+	}
+	if len(classTags) > 1 {
+		sort.Strings(classTags)
+		return fmt.Errorf("an item can only have one location, equipment or point tag, got %s",
+			strings.Join(classTags, ", "))
+	}
+
+	properties := tagsByRoot[SemanticProperty]
+	if len(properties) > 1 {
+		sort.Strings(properties)
+		return fmt.Errorf("an item can only have one property tag, got %s", strings.Join(properties, ", "))
+	}
+
+	return nil
+}
+
+// semanticTagOfRoot returns the first of the given tags belonging to the given root tag.
+func semanticTagOfRoot(tags []string, root string) string {
+	for _, tag := range tags {
+		if SemanticTagRoot(tag) == root {
+			return tag
+		}
+	}
+
+	return ""
+}
+
+// similarSemanticTag returns the semantic tag only differing in case from the given tag, if there is one.
+func similarSemanticTag(tag string) string {
+	for _, tags := range semanticTags {
+		for _, semanticTag := range tags {
+			if strings.EqualFold(semanticTag, tag) {
+				return semanticTag
+			}
+		}
+	}
+
+	return ""
+}
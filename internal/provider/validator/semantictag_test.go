@@ -0,0 +1,24 @@
+package validator
+
+import "testing"
+
+func TestValidateSemanticTags(t *testing.T) {
+	tests := []struct {
+		tags  []string
+		valid bool
+	}{
+		{tags: []string{"LivingRoom"}, valid: true},
+		{tags: []string{"Measurement", "Temperature", "Lighting"}, valid: true},
+		{tags: []string{"Lightbulb", "favorite"}, valid: true},
+		{tags: []string{"livingroom", "Kitchen"}, valid: true},
+		{tags: []string{"Kitchen", "Lightbulb"}, valid: false},
+		{tags: []string{"Switch", "Measurement"}, valid: false},
+		{tags: []string{"Measurement", "Temperature", "Humidity"}, valid: false},
+	}
+
+	for _, test := range tests {
+		if err := ValidateSemanticTags(test.tags); (err == nil) != test.valid {
+			t.Errorf("expected tags %v to be valid: %t, got error %v", test.tags, test.valid, err)
+		}
+	}
+}
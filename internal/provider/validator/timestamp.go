@@ -0,0 +1,37 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"time"
+)
+
+type timestampValidator struct {
+	tfsdk.AttributeValidator
+}
+
+func TimestampValidator() *timestampValidator {
+	return &timestampValidator{}
+}
+
+func (v timestampValidator) Description(ctx context.Context) string {
+	return "Ensures a given timestamp is a valid RFC 3339 timestamp."
+}
+
+func (v timestampValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v timestampValidator) Validate(_ context.Context, request tfsdk.ValidateAttributeRequest, response *tfsdk.ValidateAttributeResponse) {
+	value := request.AttributeConfig.(types.String)
+	if value.Null || value.Unknown {
+		return
+	}
+
+	if _, err := time.Parse(time.RFC3339, value.Value); err != nil {
+		response.Diagnostics.AddAttributeError(request.AttributePath, "Invalid timestamp",
+			fmt.Sprintf("Given timestamp '%s' is invalid, expected e.g. '2022-01-01T00:00:00Z': %s", value.Value, err))
+	}
+}
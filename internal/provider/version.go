@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/chris922/terraform-provider-openhab/internal/api"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"regexp"
+	"strconv"
+)
+
+// serverVersion is the runtime version of the configured openHAB server, qualifiers like `.M1` or `-SNAPSHOT` are
+// ignored. All methods can be called on a nil version, e.g. if the server does not report its version or in tests,
+// features are assumed to be available then.
+type serverVersion struct {
+	major, minor, patch int
+}
+
+var serverVersionPattern = regexp.MustCompile(`^(\d+)\.(\d+)(?:\.(\d+))?`)
+
+// oldest openHAB version the API client is generated for
+var minimumServerVersion = serverVersion{3, 2, 0}
+
+// serverFeature is part of the REST API or item model that is not available in all supported openHAB versions.
+type serverFeature struct {
+	description string
+	since       serverVersion
+}
+
+var (
+	featureCurrencyDimension    = serverFeature{"The dimension Currency", serverVersion{4, 1, 0}}
+	featureEnergyPriceDimension = serverFeature{"The dimension EnergyPrice", serverVersion{4, 1, 0}}
+	featureUnitMetadata         = serverFeature{"The unit metadata", serverVersion{4, 0, 0}}
+)
+
+// features required by Number item dimensions
+var dimensionFeatures = map[string]serverFeature{
+	"Currency":    featureCurrencyDimension,
+	"EnergyPrice": featureEnergyPriceDimension,
+}
+
+func parseServerVersion(version string) (*serverVersion, error) {
+	match := serverVersionPattern.FindStringSubmatch(version)
+	if match == nil {
+		return nil, fmt.Errorf("version '%s' does not start with <major>.<minor>", version)
+	}
+
+	v := &serverVersion{}
+	v.major, _ = strconv.Atoi(match[1])
+	v.minor, _ = strconv.Atoi(match[2])
+	if match[3] != "" {
+		v.patch, _ = strconv.Atoi(match[3])
+	}
+
+	return v, nil
+}
+
+// serverVersionFromRoot returns the runtime version reported by the REST root, it is nil if the version is missing.
+func serverVersionFromRoot(root *api.RootBean) (*serverVersion, error) {
+	if root.RuntimeInfo == nil || root.RuntimeInfo.Version == nil {
+		return nil, nil
+	}
+
+	return parseServerVersion(*root.RuntimeInfo.Version)
+}
+
+func (v *serverVersion) String() string {
+	if v == nil {
+		return "unknown"
+	}
+
+	return fmt.Sprintf("%d.%d.%d", v.major, v.minor, v.patch)
+}
+
+// atLeast returns true if the version is equal to or newer than the given one.
+func (v *serverVersion) atLeast(other serverVersion) bool {
+	if v == nil {
+		return true
+	}
+
+	if v.major != other.major {
+		return v.major > other.major
+	}
+	if v.minor != other.minor {
+		return v.minor > other.minor
+	}
+
+	return v.patch >= other.patch
+}
+
+// supports returns true if the server provides the given feature.
+func (v *serverVersion) supports(feature serverFeature) bool {
+	return v.atLeast(feature.since)
+}
+
+// requireFeature returns an error for the given attribute if the server does not provide the feature.
+func (v *serverVersion) requireFeature(feature serverFeature, path *tftypes.AttributePath) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if v.supports(feature) {
+		return diags
+	}
+
+	diags.AddAttributeError(path, "Unsupported openHAB Version",
+		fmt.Sprintf("%s requires openHAB %s or newer, but the server runs openHAB %s.",
+			feature.description, feature.since.String(), v))
+
+	return diags
+}
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/chris922/terraform-provider-openhab/internal/api"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestParseServerVersion(t *testing.T) {
+	tests := map[string]string{
+		"3.2.0":          "3.2.0",
+		"4.1.0.M2":       "4.1.0",
+		"4.0.0-SNAPSHOT": "4.0.0",
+		"3.4":            "3.4.0",
+	}
+
+	for version, expected := range tests {
+		v, err := parseServerVersion(version)
+		if err != nil {
+			t.Errorf("unexpected error parsing %s: %s", version, err)
+			continue
+		}
+		if v.String() != expected {
+			t.Errorf("expected %s to be parsed as %s, got %s", version, expected, v)
+		}
+	}
+
+	if _, err := parseServerVersion("unknown"); err == nil {
+		t.Errorf("expected invalid version to be rejected")
+	}
+}
+
+func TestServerVersionSupportsFeatures(t *testing.T) {
+	old := &serverVersion{3, 4, 5}
+	current := &serverVersion{4, 1, 0}
+	var unknown *serverVersion
+
+	if old.supports(featureCurrencyDimension) {
+		t.Errorf("expected openHAB %s to not support %s", old, featureCurrencyDimension.description)
+	}
+	if !current.supports(featureCurrencyDimension) || !unknown.supports(featureCurrencyDimension) {
+		t.Errorf("expected %s to be supported", featureCurrencyDimension.description)
+	}
+}
+
+func TestServerVersionFromRootWithoutRuntimeInfo(t *testing.T) {
+	version, err := serverVersionFromRoot(&api.RootBean{})
+	if err != nil || version != nil {
+		t.Errorf("expected missing version to be unknown, got %v, error: %v", version, err)
+	}
+}
+
+func TestItemModifyPlanRejectsUnsupportedDimension(t *testing.T) {
+	ctx := context.Background()
+
+	schema, diags := ItemResourceType{}.GetSchema(ctx)
+	if diags.HasError() {
+		t.Fatalf("unable to get schema: %v", diags)
+	}
+
+	data := testItemData()
+	data.Type = types.String{Value: "Number:Currency"}
+	plan := testState(t, schema, &data)
+
+	for version, expectError := range map[serverVersion]bool{{3, 2, 0}: true, {4, 1, 0}: false} {
+		version := version
+		r := itemResource{serverVersion: &version}
+
+		req := tfsdk.ModifyResourcePlanRequest{
+			Plan:  tfsdk.Plan{Schema: schema, Raw: plan.Raw},
+			State: tfsdk.State{Schema: schema, Raw: tftypes.NewValue(schema.TerraformType(ctx), nil)},
+		}
+		resp := &tfsdk.ModifyResourcePlanResponse{Plan: req.Plan}
+		r.ModifyPlan(ctx, req, resp)
+
+		if resp.Diagnostics.HasError() != expectError {
+			t.Errorf("expected error for openHAB %s to be %t, got %v", version.String(), expectError, resp.Diagnostics)
+		}
+	}
+}
+
+func TestItemModifyPlanRejectsUnitOnOldServer(t *testing.T) {
+	ctx := context.Background()
+
+	schema, diags := ItemResourceType{}.GetSchema(ctx)
+	if diags.HasError() {
+		t.Fatalf("unable to get schema: %v", diags)
+	}
+
+	data := testItemData()
+	data.Type = types.String{Value: "Number:Power"}
+	data.Unit = types.String{Value: "kW"}
+	plan := testState(t, schema, &data)
+
+	for version, expectError := range map[serverVersion]bool{{3, 4, 0}: true, {4, 0, 0}: false} {
+		version := version
+		r := itemResource{serverVersion: &version}
+
+		req := tfsdk.ModifyResourcePlanRequest{
+			Plan:  tfsdk.Plan{Schema: schema, Raw: plan.Raw},
+			State: tfsdk.State{Schema: schema, Raw: tftypes.NewValue(schema.TerraformType(ctx), nil)},
+		}
+		resp := &tfsdk.ModifyResourcePlanResponse{Plan: req.Plan}
+		r.ModifyPlan(ctx, req, resp)
+
+		if resp.Diagnostics.HasError() != expectError {
+			t.Errorf("expected error for openHAB %s to be %t, got %v", version.String(), expectError, resp.Diagnostics)
+		}
+	}
+}
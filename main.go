@@ -18,6 +18,10 @@ import (
 // can be customized.
 //go:generate go run github.com/hashicorp/terraform-plugin-docs/cmd/tfplugindocs
 
+// Generate the openHAB API client out of the OpenAPI spec. Configurations are extended with `x-go-type` in the spec
+// to be passed through as plain maps.
+//go:generate go run github.com/deepmap/oapi-codegen/cmd/oapi-codegen -generate types,client,server,spec -package api -o internal/api/api.go internal/api/apispec-openhab-3.2.0.json
+
 var (
 	// these will be set by the goreleaser configuration
 	// to appropriate values for the compiled binary
@@ -5,4 +5,7 @@ package tools
 import (
 	// Documentation generation
 	_ "github.com/hashicorp/terraform-plugin-docs/cmd/tfplugindocs"
+
+	// API client generation
+	_ "github.com/deepmap/oapi-codegen/cmd/oapi-codegen"
 )